@@ -30,7 +30,11 @@ import (
 	"cezzis.com/cezzis-mcp-server/internal/config"
 	"cezzis.com/cezzis-mcp-server/internal/db"
 	"cezzis.com/cezzis-mcp-server/internal/environment"
+	"cezzis.com/cezzis-mcp-server/internal/imaging"
 	"cezzis.com/cezzis-mcp-server/internal/mcpserver"
+	"cezzis.com/cezzis-mcp-server/internal/prompts"
+	"cezzis.com/cezzis-mcp-server/internal/repos"
+	"cezzis.com/cezzis-mcp-server/internal/resources"
 	"cezzis.com/cezzis-mcp-server/internal/telemetry"
 	"cezzis.com/cezzis-mcp-server/internal/tools"
 )
@@ -74,6 +78,8 @@ func main() {
 		"Cezzi Cocktails Server",
 		"1.0.0",
 		server.WithToolCapabilities(true),
+		server.WithResourceCapabilities(false, false),
+		server.WithPromptCapabilities(false),
 		server.WithLogging(),
 	)
 
@@ -94,16 +100,39 @@ func main() {
 		panic(err)
 	}
 
+	// Bar inventories are stored locally as the accounts API only keeps each bar's name and description
+	barInventory := repos.NewPostgresBarInventoryRepository(pool)
+
+	// Cocktail images are downscaled and cached before being returned as MCP image content
+	var imageFetcher *imaging.Fetcher
+	if settings.CocktailImagesEnabled {
+		imageFetcher = imaging.NewFetcher(settings)
+	}
+
 	// Add the various tools to the MCP server
 	// Each tool is registered with its corresponding handler function.
 	// This allows clients to invoke the tools via the MCP protocol.
 
 	// Basic cocktail tools (no authentication required)
-	mcpServer.AddTool(tools.CocktailGetTool, server.ToolHandlerFunc(tools.NewCocktailGetToolHandler(cocktailsClient).Handle))
-	mcpServer.AddTool(tools.CocktailSearchTool, server.ToolHandlerFunc(tools.NewCocktailSearchToolHandler(aiSearchClient).Handle))
+	mcpServer.AddTool(tools.CocktailGetTool, server.ToolHandlerFunc(tools.NewCocktailGetToolHandler(cocktailsClient, authManager, accountsClient, imageFetcher).Handle))
+	mcpServer.AddTool(tools.CocktailSearchTool, server.ToolHandlerFunc(tools.NewCocktailSearchToolHandler(aiSearchClient, authManager, accountsClient, imageFetcher).Handle))
+	mcpServer.AddTool(tools.CocktailRelatedTool, server.ToolHandlerFunc(tools.NewCocktailRelatedToolHandler(aiSearchClient).Handle))
+	mcpServer.AddTool(tools.CocktailScaleTool, server.ToolHandlerFunc(tools.NewCocktailScaleToolHandler(cocktailsClient).Handle))
+	mcpServer.AddTool(tools.CocktailStrengthTool, server.ToolHandlerFunc(tools.NewCocktailStrengthToolHandler(cocktailsClient).Handle))
+	mcpServer.AddTool(tools.ShoppingListTool, server.ToolHandlerFunc(tools.NewShoppingListToolHandler(authManager, accountsClient, cocktailsClient, barInventory).Handle))
+
+	// Ingredient catalog tools (no authentication required)
+	mcpServer.AddTool(tools.ListIngredientsTool, server.ToolHandlerFunc(tools.NewListIngredientsToolHandler(cocktailsClient).Handle))
+	mcpServer.AddTool(tools.GetIngredientTool, server.ToolHandlerFunc(tools.NewGetIngredientToolHandler(cocktailsClient).Handle))
+	mcpServer.AddTool(tools.GetIngredientFiltersTool, server.ToolHandlerFunc(tools.NewGetIngredientFiltersToolHandler(cocktailsClient).Handle))
+
+	// Cocktail collection tools (no authentication required)
+	mcpServer.AddTool(tools.ListCollectionsTool, server.ToolHandlerFunc(tools.NewListCollectionsToolHandler(cocktailsClient).Handle))
+	mcpServer.AddTool(tools.GetCollectionTool, server.ToolHandlerFunc(tools.NewGetCollectionToolHandler(cocktailsClient).Handle))
 
 	// Simple formating and cleaning tools (no authentication required)
 	mcpServer.AddTool(tools.ConvertToPlainTextTool, server.ToolHandlerFunc(tools.NewConvertToPlainTextToolHandler().Handle))
+	mcpServer.AddTool(tools.ConvertUnitsTool, server.ToolHandlerFunc(tools.NewConvertUnitsToolHandler().Handle))
 
 	// Authentication tools
 	mcpServer.AddTool(tools.AuthLoginTool, server.ToolHandlerFunc(tools.NewAuthLoginToolHandler(authManager).Handle))
@@ -112,6 +141,66 @@ func main() {
 
 	// Account Authenticated tools (require user login)
 	mcpServer.AddTool(tools.RateCocktailTool, server.ToolHandlerFunc(tools.NewRateCocktailToolHandler(authManager, accountsClient).Handle))
+	mcpServer.AddTool(tools.ListRatingsTool, server.ToolHandlerFunc(tools.NewListRatingsToolHandler(authManager, accountsClient, cocktailsClient).Handle))
+	mcpServer.AddTool(tools.ShareCocktailTool, server.ToolHandlerFunc(tools.NewShareCocktailToolHandler(authManager, accountsClient).Handle))
+
+	// Account profile tools (require user login)
+	mcpServer.AddTool(tools.GetProfileTool, server.ToolHandlerFunc(tools.NewGetProfileToolHandler(authManager, accountsClient).Handle))
+	mcpServer.AddTool(tools.UpdateProfileTool, server.ToolHandlerFunc(tools.NewUpdateProfileToolHandler(authManager, accountsClient).Handle))
+	mcpServer.AddTool(tools.UploadAvatarTool, server.ToolHandlerFunc(tools.NewUploadAvatarToolHandler(authManager, accountsClient).Handle))
+	mcpServer.AddTool(tools.SetMeasurementSystemTool, server.ToolHandlerFunc(tools.NewSetMeasurementSystemToolHandler(authManager, accountsClient).Handle))
+	mcpServer.AddTool(tools.NewCocktailNotificationsTool, server.ToolHandlerFunc(tools.NewNewCocktailNotificationsToolHandler(authManager, accountsClient).Handle))
+
+	// Account favorite cocktail tools (require user login)
+	mcpServer.AddTool(tools.ManageFavoritesTool, server.ToolHandlerFunc(tools.NewManageFavoritesToolHandler(authManager, accountsClient).Handle))
+	mcpServer.AddTool(tools.ListFavoritesTool, server.ToolHandlerFunc(tools.NewListFavoritesToolHandler(authManager, accountsClient, cocktailsClient).Handle))
+
+	// Account search history tools (require user login)
+	mcpServer.AddTool(tools.ListRecentSearchesTool, server.ToolHandlerFunc(tools.NewListRecentSearchesToolHandler(authManager, accountsClient).Handle))
+	mcpServer.AddTool(tools.ClearRecentSearchesTool, server.ToolHandlerFunc(tools.NewClearRecentSearchesToolHandler(authManager, accountsClient).Handle))
+
+	// Account cocktail list tools (require user login)
+	mcpServer.AddTool(tools.ListCocktailListsTool, server.ToolHandlerFunc(tools.NewListCocktailListsToolHandler(authManager, accountsClient).Handle))
+	mcpServer.AddTool(tools.GetCocktailListTool, server.ToolHandlerFunc(tools.NewGetCocktailListToolHandler(authManager, accountsClient, cocktailsClient).Handle))
+	mcpServer.AddTool(tools.CreateCocktailListTool, server.ToolHandlerFunc(tools.NewCreateCocktailListToolHandler(authManager, accountsClient).Handle))
+	mcpServer.AddTool(tools.UpdateCocktailListTool, server.ToolHandlerFunc(tools.NewUpdateCocktailListToolHandler(authManager, accountsClient).Handle))
+	mcpServer.AddTool(tools.DeleteCocktailListTool, server.ToolHandlerFunc(tools.NewDeleteCocktailListToolHandler(authManager, accountsClient).Handle))
+	mcpServer.AddTool(tools.ReorderCocktailListsTool, server.ToolHandlerFunc(tools.NewReorderCocktailListsToolHandler(authManager, accountsClient).Handle))
+	mcpServer.AddTool(tools.ReorderCocktailListItemsTool, server.ToolHandlerFunc(tools.NewReorderCocktailListItemsToolHandler(authManager, accountsClient).Handle))
+	mcpServer.AddTool(tools.RecordCocktailListUsageTool, server.ToolHandlerFunc(tools.NewRecordCocktailListUsageToolHandler(authManager, accountsClient).Handle))
+
+	// Account bar tools (require user login)
+	mcpServer.AddTool(tools.ListBarsTool, server.ToolHandlerFunc(tools.NewListBarsToolHandler(authManager, accountsClient).Handle))
+	mcpServer.AddTool(tools.CreateBarTool, server.ToolHandlerFunc(tools.NewCreateBarToolHandler(authManager, accountsClient).Handle))
+	mcpServer.AddTool(tools.UpdateBarTool, server.ToolHandlerFunc(tools.NewUpdateBarToolHandler(authManager, accountsClient).Handle))
+	mcpServer.AddTool(tools.DeleteBarTool, server.ToolHandlerFunc(tools.NewDeleteBarToolHandler(authManager, accountsClient, barInventory).Handle))
+	mcpServer.AddTool(tools.ReorderBarsTool, server.ToolHandlerFunc(tools.NewReorderBarsToolHandler(authManager, accountsClient).Handle))
+	mcpServer.AddTool(tools.ListBarIngredientsTool, server.ToolHandlerFunc(tools.NewListBarIngredientsToolHandler(authManager, accountsClient, barInventory).Handle))
+	mcpServer.AddTool(tools.AddBarIngredientsTool, server.ToolHandlerFunc(tools.NewAddBarIngredientsToolHandler(authManager, accountsClient, cocktailsClient, barInventory).Handle))
+	mcpServer.AddTool(tools.RemoveBarIngredientsTool, server.ToolHandlerFunc(tools.NewRemoveBarIngredientsToolHandler(authManager, accountsClient, barInventory).Handle))
+	mcpServer.AddTool(tools.WhatCanIMakeTool, server.ToolHandlerFunc(tools.NewWhatCanIMakeToolHandler(authManager, accountsClient, aiSearchClient, cocktailsClient, barInventory).Handle))
+
+	// Add the resources and resource templates to the MCP server
+	// These allow clients to attach Cezzis.com content as context without a tool call.
+	collectionResourceHandler := resources.NewCollectionResourceHandler(cocktailsClient)
+	mcpServer.AddResource(resources.CollectionsResource, server.ResourceHandlerFunc(collectionResourceHandler.HandleList))
+	mcpServer.AddResourceTemplate(resources.CollectionResourceTemplate, server.ResourceTemplateHandlerFunc(collectionResourceHandler.HandleTemplate))
+
+	legalResourceHandler := resources.NewLegalResourceHandler(cocktailsClient)
+	mcpServer.AddResource(resources.PrivacyPolicyResource, server.ResourceHandlerFunc(legalResourceHandler.HandlePrivacyPolicy))
+	mcpServer.AddResource(resources.TermsOfServiceResource, server.ResourceHandlerFunc(legalResourceHandler.HandleTermsOfService))
+
+	cocktailResourceHandler := resources.NewCocktailResourceHandler(cocktailsClient)
+	mcpServer.AddResource(resources.RecentCocktailsResource, server.ResourceHandlerFunc(cocktailResourceHandler.HandleRecent))
+	mcpServer.AddResourceTemplate(resources.CocktailResourceTemplate, server.ResourceTemplateHandlerFunc(cocktailResourceHandler.HandleJSON))
+	mcpServer.AddResourceTemplate(resources.CocktailMarkdownResourceTemplate, server.ResourceTemplateHandlerFunc(cocktailResourceHandler.HandleMarkdown))
+
+	// Add the prompts to the MCP server
+	// These give clients ready-made starting points for common cocktail workflows built on the tools above.
+	mcpServer.AddPrompt(prompts.PartyMenuPrompt, prompts.HandlePartyMenuPrompt)
+	mcpServer.AddPrompt(prompts.WhatCanIMakePrompt, prompts.HandleWhatCanIMakePrompt)
+	mcpServer.AddPrompt(prompts.CocktailHistoryPrompt, prompts.HandleCocktailHistoryPrompt)
+	mcpServer.AddPrompt(prompts.MoodPrompt, prompts.HandleMoodPrompt)
 
 	// Finally, start the server in the chosen mode
 	// Proper error handling ensures that any issues during startup are logged.
@@ -0,0 +1,60 @@
+// Package upstream reads the responses of the Cezzis.com APIs the MCP server calls, so the tools and resources
+// report failed calls and non-success responses the same way.
+package upstream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+// ReadResponse reads and closes the body of an upstream API response.  It returns an error if the
+// call itself failed or if the upstream API responded with a non-success status code.  The operation
+// describes the call being made and is used for logging and error messages.
+func ReadResponse(ctx context.Context, rs *http.Response, callErr error, operation string) ([]byte, error) {
+	if callErr != nil {
+		telemetry.Logger.Err(callErr).Ctx(ctx).Msg("MCP Error " + operation)
+		return nil, callErr
+	}
+
+	defer func() {
+		if closeErr := rs.Body.Close(); closeErr != nil {
+			telemetry.Logger.Warn().Ctx(ctx).Msg(fmt.Sprintf("MCP Warning: failed to close response body: %v", closeErr))
+		}
+	}()
+
+	bodyBytes, readErr := io.ReadAll(rs.Body)
+	if readErr != nil {
+		telemetry.Logger.Err(readErr).Ctx(ctx).Msg("MCP Error reading rs body when " + operation)
+		return nil, readErr
+	}
+
+	if rs.StatusCode < http.StatusOK || rs.StatusCode >= http.StatusMultipleChoices {
+		err := fmt.Errorf("%s failed (status %d): %s", operation, rs.StatusCode, strings.TrimSpace(string(bodyBytes)))
+		telemetry.Logger.Err(err).Ctx(ctx).Int("status_code", rs.StatusCode).Msg("MCP Error " + operation)
+		return nil, err
+	}
+
+	return bodyBytes, nil
+}
+
+// DecodeResponse reads an upstream API response using ReadResponse and unmarshals the JSON body into T.
+func DecodeResponse[T any](ctx context.Context, rs *http.Response, callErr error, operation string) (*T, error) {
+	bodyBytes, err := ReadResponse(ctx, rs, callErr, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	var result T
+	if err := json.Unmarshal(bodyBytes, &result); err != nil {
+		telemetry.Logger.Err(err).Ctx(ctx).Msg("MCP Error parsing rs body when " + operation)
+		return nil, fmt.Errorf("failed to parse response when %s: %w", operation, err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,53 @@
+package upstream_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/upstream"
+)
+
+func response(status int, body string) *http.Response {
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body))}
+}
+
+func Test_readresponse_returns_the_body_of_successful_responses(t *testing.T) {
+	t.Parallel()
+
+	body, err := upstream.ReadResponse(context.Background(), response(http.StatusOK, `{"id":"negroni"}`), nil, "getting cocktail")
+
+	require.NoError(t, err)
+	require.Equal(t, `{"id":"negroni"}`, string(body))
+}
+
+func Test_readresponse_reports_failed_calls_and_statuses(t *testing.T) {
+	t.Parallel()
+
+	callErr := errors.New("connection refused")
+	_, err := upstream.ReadResponse(context.Background(), nil, callErr, "getting cocktail")
+	require.ErrorIs(t, err, callErr)
+
+	_, err = upstream.ReadResponse(context.Background(), response(http.StatusNotFound, " not found \n"), nil, "getting cocktail")
+	require.EqualError(t, err, "getting cocktail failed (status 404): not found")
+}
+
+func Test_decoderesponse_unmarshals_the_body(t *testing.T) {
+	t.Parallel()
+
+	type cocktail struct {
+		ID string `json:"id"`
+	}
+
+	decoded, err := upstream.DecodeResponse[cocktail](context.Background(), response(http.StatusOK, `{"id":"negroni"}`), nil, "getting cocktail")
+	require.NoError(t, err)
+	require.Equal(t, "negroni", decoded.ID)
+
+	_, err = upstream.DecodeResponse[cocktail](context.Background(), response(http.StatusOK, `not json`), nil, "getting cocktail")
+	require.ErrorContains(t, err, "failed to parse response when getting cocktail")
+}
@@ -42,13 +42,16 @@ type DeviceCodeResponse struct {
 type OAuthFlowManager struct {
 	appSettings *config.AppSettings
 	httpClient  *http.Client
-	storage     *TokenStorage
+	storage     TokenStore
 }
 
 // NewOAuthFlowManager creates a new OAuth flow manager
 func NewOAuthFlowManager(pool *pgxpool.Pool) *OAuthFlowManager {
-	storage := NewTokenStorage(pool)
+	return NewOAuthFlowManagerWithStore(NewTokenStorage(pool))
+}
 
+// NewOAuthFlowManagerWithStore creates a new OAuth flow manager that keeps session tokens in the supplied store
+func NewOAuthFlowManagerWithStore(storage TokenStore) *OAuthFlowManager {
 	manager := &OAuthFlowManager{
 		appSettings: config.GetAppSettings(),
 		httpClient:  &http.Client{Timeout: 30 * time.Second},
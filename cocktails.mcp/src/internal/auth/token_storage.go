@@ -11,6 +11,13 @@ import (
 	"cezzis.com/cezzis-mcp-server/internal/telemetry"
 )
 
+// TokenStore saves, loads and clears the OAuth tokens of MCP sessions
+type TokenStore interface {
+	SaveToken(ctx context.Context, sessionID string, tokens *TokenResponse) error
+	GetToken(ctx context.Context, sessionID string) (*TokenResponse, error)
+	ClearTokens(ctx context.Context, sessionID string) error
+}
+
 // TokenStorage handles secure storage and retrieval of OAuth tokens
 type TokenStorage struct {
 	repo *repos.PostgresTokenRepository
@@ -5,6 +5,7 @@
 // The package supports configuration for:
 //   - Cocktails API connection settings (host and subscription key)
 //   - Auth0 authentication settings (domain, client id, audience, scopes)
+//   - Cocktail image settings (max dimension and caching)
 //
 // Configuration is loaded from environment variables and .env files located in the
 // executable directory. The package uses a thread-safe singleton pattern to ensure
@@ -121,6 +122,29 @@ type AppSettings struct {
 	// CezzisBaseURL is the base URL for Cezzis.com website.
 	// Example: "https://www.cezzis.com"
 	CezzisBaseURL string `env:"CEZZIS_BASE_URL" envDefault:""`
+
+	// CocktailImagesEnabled controls whether cocktail images are returned as MCP image content.
+	// Default is true.
+	CocktailImagesEnabled bool `env:"COCKTAIL_IMAGES_ENABLED" envDefault:"true"`
+
+	// CocktailImageMaxDimension is the maximum width or height in pixels of the cocktail images
+	// returned as MCP image content.  Larger images are downscaled.
+	// Default is 512.
+	CocktailImageMaxDimension int `env:"COCKTAIL_IMAGE_MAX_DIMENSION" envDefault:"512"`
+
+	// CocktailImageCacheEntries is the number of downscaled cocktail images kept in the in-memory cache.
+	// Default is 128.
+	CocktailImageCacheEntries int `env:"COCKTAIL_IMAGE_CACHE_ENTRIES" envDefault:"128"`
+
+	// CocktailImageCacheDir is an optional directory where downscaled cocktail images are cached on disk.
+	// When empty only the in-memory cache is used.
+	// Example: "/tmp/cezzis-mcp-images"
+	CocktailImageCacheDir string `env:"COCKTAIL_IMAGE_CACHE_DIR" envDefault:""`
+
+	// CocktailImageCacheDirMaxBytes bounds the size of the on-disk image cache.  The least recently used images
+	// are removed once it is exceeded.  Zero or less leaves the directory unbounded.
+	// Default is 104857600 (100 MiB).
+	CocktailImageCacheDirMaxBytes int64 `env:"COCKTAIL_IMAGE_CACHE_DIR_MAX_BYTES" envDefault:"104857600"`
 }
 
 // GetAppSettings returns a singleton instance of AppSettings loaded from environment variables.
@@ -99,6 +99,21 @@ func EnsureTablesExist(ctx context.Context, pool *pgxpool.Pool) error {
 		return fmt.Errorf("failed to create session_tokens table: %w", err)
 	}
 
+	_, err = pool.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS bar_ingredients (
+			user_id TEXT NOT NULL,
+			bar_id TEXT NOT NULL,
+			ingredient_id TEXT NOT NULL,
+			ingredient_name TEXT NOT NULL,
+			parent_id TEXT NOT NULL DEFAULT '',
+			added_on TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (user_id, bar_id, ingredient_id)
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create bar_ingredients table: %w", err)
+	}
+
 	telemetry.Logger.Info().Msg("Database tables ensured")
 	return nil
 }
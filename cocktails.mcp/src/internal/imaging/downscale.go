@@ -0,0 +1,99 @@
+package imaging
+
+import (
+	"image"
+	"image/draw"
+	"math"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+)
+
+// BestFit picks the image variant best suited to the maximum dimension: the smallest image that is at least as large
+// as the maximum dimension, so it can be downscaled without losing detail, or otherwise the largest image available.
+// It returns false if there are no images with a uri.
+func BestFit(images []cocktailsapi.CocktailImageModel, maxDimension int) (cocktailsapi.CocktailImageModel, bool) {
+	var best cocktailsapi.CocktailImageModel
+	found := false
+
+	for _, candidate := range images {
+		if candidate.Uri == "" {
+			continue
+		}
+
+		if !found {
+			best, found = candidate, true
+			continue
+		}
+
+		size, bestSize := longestSide(candidate), longestSide(best)
+		switch {
+		case bestSize < maxDimension:
+			// The current best is too small, so any larger image is better
+			if size > bestSize {
+				best = candidate
+			}
+		case size >= maxDimension && size < bestSize:
+			// Both are large enough, so prefer the smaller download
+			best = candidate
+		}
+	}
+
+	return best, found
+}
+
+// longestSide returns the larger of the image's width and height.
+func longestSide(img cocktailsapi.CocktailImageModel) int {
+	return int(max(img.Width, img.Height))
+}
+
+// Downscale shrinks the image so neither side exceeds maxDimension, preserving its aspect ratio.  Each destination
+// pixel is the average of the source pixels it covers, which avoids the aliasing of nearest-neighbor sampling.
+// Images already within the maximum dimension are returned unchanged.
+func Downscale(src image.Image, maxDimension int) image.Image {
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	if maxDimension <= 0 || (width <= maxDimension && height <= maxDimension) {
+		return src
+	}
+
+	scale := float64(maxDimension) / float64(max(width, height))
+	dstWidth := max(1, int(math.Round(float64(width)*scale)))
+	dstHeight := max(1, int(math.Round(float64(height)*scale)))
+
+	source := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(source, source.Bounds(), src, bounds.Min, draw.Src)
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+
+	for y := range dstHeight {
+		y0 := y * height / dstHeight
+		y1 := max((y+1)*height/dstHeight, y0+1)
+
+		for x := range dstWidth {
+			x0 := x * width / dstWidth
+			x1 := max((x+1)*width/dstWidth, x0+1)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				offset := source.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					r += uint64(source.Pix[offset])
+					g += uint64(source.Pix[offset+1])
+					b += uint64(source.Pix[offset+2])
+					a += uint64(source.Pix[offset+3])
+					n++
+					offset += 4
+				}
+			}
+
+			offset := dst.PixOffset(x, y)
+			dst.Pix[offset] = uint8(r / n)
+			dst.Pix[offset+1] = uint8(g / n)
+			dst.Pix[offset+2] = uint8(b / n)
+			dst.Pix[offset+3] = uint8(a / n)
+		}
+	}
+
+	return dst
+}
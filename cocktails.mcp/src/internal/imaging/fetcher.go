@@ -0,0 +1,356 @@
+// Package imaging fetches cocktail images for the Cezzi Cocktails MCP server, downscales
+// them to a configurable maximum dimension and caches the results in memory and,
+// optionally, on disk so vision-capable MCP clients can be sent the drink itself.
+package imaging
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	// Registers the GIF decoder so GIF images can be downscaled.
+	_ "image/gif"
+
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+const (
+	// maxSourceBytes is the largest source image that is downloaded.
+	maxSourceBytes = 10 << 20
+	// maxSourcePixels is the largest source image, in pixels, that is decoded.  Compressed images well within
+	// maxSourceBytes can declare enormous dimensions, so this bounds the memory used to decode them.
+	maxSourcePixels = 6000 * 4000
+	// maxPassthroughBytes is the largest image in a format that cannot be decoded, such as WebP, that is
+	// returned unchanged rather than downscaled.
+	maxPassthroughBytes = 1 << 20
+	// jpegQuality is the quality used when encoding downscaled JPEG images.
+	jpegQuality = 85
+)
+
+// Image is a downscaled image ready to be returned as MCP image content.
+type Image struct {
+	Data     []byte
+	MIMEType string
+}
+
+// Fetcher downloads, downscales and caches images.  It is safe for concurrent use.
+type Fetcher struct {
+	httpClient   *http.Client
+	maxDimension int
+	maxEntries   int
+	cacheDir     string
+	maxDiskBytes int64
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+// cacheEntry is an image held in the in-memory cache.
+type cacheEntry struct {
+	key   string
+	image *Image
+}
+
+// NewFetcher creates a new instance of Fetcher configured from the cocktail image settings.
+func NewFetcher(settings *config.AppSettings) *Fetcher {
+	return &Fetcher{
+		httpClient:   &http.Client{Timeout: 15 * time.Second},
+		maxDimension: settings.CocktailImageMaxDimension,
+		maxEntries:   settings.CocktailImageCacheEntries,
+		cacheDir:     settings.CocktailImageCacheDir,
+		maxDiskBytes: settings.CocktailImageCacheDirMaxBytes,
+		entries:      make(map[string]*list.Element),
+		order:        list.New(),
+	}
+}
+
+// MaxDimension returns the maximum width or height in pixels of the images returned by the fetcher.
+func (f *Fetcher) MaxDimension() int {
+	return f.maxDimension
+}
+
+// Fetch returns the image at the uri downscaled to the fetcher's maximum dimension, using the cache when possible.
+func (f *Fetcher) Fetch(ctx context.Context, uri string) (*Image, error) {
+	key := f.cacheKey(uri)
+
+	if cached := f.fromMemory(key); cached != nil {
+		return cached, nil
+	}
+
+	if cached := f.fromDisk(ctx, key); cached != nil {
+		f.toMemory(key, cached)
+		return cached, nil
+	}
+
+	source, err := f.download(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := f.downscale(source)
+	if err != nil {
+		return nil, fmt.Errorf("processing image %s: %w", uri, err)
+	}
+
+	f.toMemory(key, img)
+	f.toDisk(ctx, key, img)
+
+	return img, nil
+}
+
+// download retrieves the source image bytes, rejecting failed responses and oversized images.
+func (f *Fetcher) download(ctx context.Context, uri string) ([]byte, error) {
+	rq, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := f.httpClient.Do(rq)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if closeErr := rs.Body.Close(); closeErr != nil {
+			telemetry.Logger.Warn().Ctx(ctx).Msg(fmt.Sprintf("MCP Warning: failed to close image response body: %v", closeErr))
+		}
+	}()
+
+	if rs.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("downloading image %s failed (status %d)", uri, rs.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(rs.Body, maxSourceBytes+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > maxSourceBytes {
+		return nil, fmt.Errorf("image %s exceeds the %d MB limit", uri, maxSourceBytes>>20)
+	}
+
+	return data, nil
+}
+
+// downscale decodes the source image, shrinks it to fit the maximum dimension and re-encodes it.  PNG images stay
+// PNG to preserve transparency and everything else is encoded as JPEG.  Images already within the maximum dimension
+// are returned unchanged, and images larger than maxSourcePixels are rejected before they are decoded.
+func (f *Fetcher) downscale(source []byte) (*Image, error) {
+	mimeType := http.DetectContentType(source)
+
+	imageConfig, _, err := image.DecodeConfig(bytes.NewReader(source))
+	if err != nil {
+		if errors.Is(err, image.ErrFormat) && mimeType == "image/webp" && len(source) <= maxPassthroughBytes {
+			return &Image{Data: source, MIMEType: mimeType}, nil
+		}
+		return nil, err
+	}
+
+	if imageConfig.Width*imageConfig.Height > maxSourcePixels {
+		return nil, fmt.Errorf("image of %dx%d pixels exceeds the %d megapixel limit", imageConfig.Width, imageConfig.Height, maxSourcePixels/1_000_000)
+	}
+
+	if imageConfig.Width <= f.maxDimension && imageConfig.Height <= f.maxDimension {
+		return &Image{Data: source, MIMEType: mimeType}, nil
+	}
+
+	decoded, format, err := image.Decode(bytes.NewReader(source))
+	if err != nil {
+		return nil, err
+	}
+
+	resized := Downscale(decoded, f.maxDimension)
+
+	var buf bytes.Buffer
+	if format == "png" {
+		err = png.Encode(&buf, resized)
+		mimeType = "image/png"
+	} else {
+		err = jpeg.Encode(&buf, resized, &jpeg.Options{Quality: jpegQuality})
+		mimeType = "image/jpeg"
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Image{Data: buf.Bytes(), MIMEType: mimeType}, nil
+}
+
+// cacheKey returns the cache key for the uri at the fetcher's maximum dimension.
+func (f *Fetcher) cacheKey(uri string) string {
+	sum := sha256.Sum256(fmt.Appendf(nil, "%d|%s", f.maxDimension, uri))
+	return hex.EncodeToString(sum[:])
+}
+
+// fromMemory returns the cached image for the key, marking it as recently used.
+func (f *Fetcher) fromMemory(key string) *Image {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	element, ok := f.entries[key]
+	if !ok {
+		return nil
+	}
+
+	f.order.MoveToFront(element)
+
+	return element.Value.(*cacheEntry).image
+}
+
+// toMemory caches the image for the key, evicting the least recently used images beyond the cache size.
+func (f *Fetcher) toMemory(key string, img *Image) {
+	if f.maxEntries <= 0 {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if element, ok := f.entries[key]; ok {
+		element.Value.(*cacheEntry).image = img
+		f.order.MoveToFront(element)
+		return
+	}
+
+	f.entries[key] = f.order.PushFront(&cacheEntry{key: key, image: img})
+
+	for f.order.Len() > f.maxEntries {
+		oldest := f.order.Back()
+		f.order.Remove(oldest)
+		delete(f.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+// fromDisk returns the image cached on disk for the key, if disk caching is enabled.
+func (f *Fetcher) fromDisk(ctx context.Context, key string) *Image {
+	if f.cacheDir == "" {
+		return nil
+	}
+
+	path := filepath.Join(f.cacheDir, key)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			telemetry.Logger.Warn().Ctx(ctx).Err(err).Msg("MCP Warning: failed to read cached image")
+		}
+		return nil
+	}
+
+	// Refresh the modification time so pruneDisk treats the image as recently used.
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+
+	return &Image{Data: data, MIMEType: http.DetectContentType(data)}
+}
+
+// toDisk caches the image on disk for the key, if disk caching is enabled.  Failures are logged and otherwise ignored
+// because the cache is only an optimization.
+func (f *Fetcher) toDisk(ctx context.Context, key string, img *Image) {
+	if f.cacheDir == "" {
+		return
+	}
+
+	if err := os.MkdirAll(f.cacheDir, 0o750); err != nil {
+		telemetry.Logger.Warn().Ctx(ctx).Err(err).Msg("MCP Warning: failed to create image cache directory")
+		return
+	}
+
+	// Write to a temporary file first so concurrent readers never see a partially written image.
+	tmp, err := os.CreateTemp(f.cacheDir, key+".*.tmp")
+	if err != nil {
+		telemetry.Logger.Warn().Ctx(ctx).Err(err).Msg("MCP Warning: failed to cache image")
+		return
+	}
+
+	_, writeErr := tmp.Write(img.Data)
+	closeErr := tmp.Close()
+
+	if err := errors.Join(writeErr, closeErr); err != nil {
+		telemetry.Logger.Warn().Ctx(ctx).Err(err).Msg("MCP Warning: failed to cache image")
+		_ = os.Remove(tmp.Name())
+		return
+	}
+
+	if err := os.Rename(tmp.Name(), filepath.Join(f.cacheDir, key)); err != nil {
+		telemetry.Logger.Warn().Ctx(ctx).Err(err).Msg("MCP Warning: failed to cache image")
+		_ = os.Remove(tmp.Name())
+		return
+	}
+
+	f.pruneDisk(ctx)
+}
+
+// pruneDisk removes the least recently used images from the disk cache until it is within maxDiskBytes.  Reads
+// refresh the modification time of an image, so the oldest images are the least recently used.  Only files named
+// like cache keys are considered, so anything else in the directory is left alone.
+func (f *Fetcher) pruneDisk(ctx context.Context) {
+	if f.maxDiskBytes <= 0 {
+		return
+	}
+
+	entries, err := os.ReadDir(f.cacheDir)
+	if err != nil {
+		telemetry.Logger.Warn().Ctx(ctx).Err(err).Msg("MCP Warning: failed to list image cache directory")
+		return
+	}
+
+	type cachedFile struct {
+		path     string
+		size     int64
+		modified time.Time
+	}
+
+	files := make([]cachedFile, 0, len(entries))
+	var total int64
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || len(entry.Name()) != hex.EncodedLen(sha256.Size) {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+
+		files = append(files, cachedFile{path: filepath.Join(f.cacheDir, entry.Name()), size: info.Size(), modified: info.ModTime()})
+		total += info.Size()
+	}
+
+	if total <= f.maxDiskBytes {
+		return
+	}
+
+	slices.SortFunc(files, func(a, b cachedFile) int { return a.modified.Compare(b.modified) })
+
+	for _, file := range files {
+		if total <= f.maxDiskBytes {
+			break
+		}
+
+		if err := os.Remove(file.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			telemetry.Logger.Warn().Ctx(ctx).Err(err).Msg("MCP Warning: failed to remove cached image")
+			continue
+		}
+
+		total -= file.size
+	}
+}
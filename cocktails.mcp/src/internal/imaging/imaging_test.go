@@ -0,0 +1,217 @@
+package imaging_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/imaging"
+)
+
+func solidPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			img.Set(x, y, color.RGBA{R: 200, G: 40, B: 40, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+
+	return buf.Bytes()
+}
+
+func imageServer(t *testing.T, data []byte) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &hits
+}
+
+func Test_downscale_preserves_aspect_ratio_and_color(t *testing.T) {
+	t.Parallel()
+
+	src := image.NewRGBA(image.Rect(0, 0, 400, 200))
+	for y := range 200 {
+		for x := range 400 {
+			src.Set(x, y, color.RGBA{R: 10, G: 20, B: 30, A: 255})
+		}
+	}
+
+	dst := imaging.Downscale(src, 100)
+
+	require.Equal(t, image.Rect(0, 0, 100, 50), dst.Bounds())
+	require.Equal(t, color.RGBA{R: 10, G: 20, B: 30, A: 255}, dst.At(50, 25))
+	require.Same(t, src, imaging.Downscale(src, 400))
+}
+
+func Test_best_fit_prefers_smallest_image_covering_max_dimension(t *testing.T) {
+	t.Parallel()
+
+	images := []cocktailsapi.CocktailImageModel{
+		{Uri: "large", Width: 2000, Height: 1500},
+		{Uri: "thumb", Width: 150, Height: 100},
+		{Uri: "medium", Width: 600, Height: 400},
+		{Uri: "", Width: 512, Height: 512},
+	}
+
+	best, ok := imaging.BestFit(images, 512)
+	require.True(t, ok)
+	require.Equal(t, "medium", best.Uri)
+
+	best, ok = imaging.BestFit(images[1:2], 512)
+	require.True(t, ok)
+	require.Equal(t, "thumb", best.Uri)
+
+	_, ok = imaging.BestFit(nil, 512)
+	require.False(t, ok)
+}
+
+func Test_fetcher_downscales_and_caches_in_memory(t *testing.T) {
+	t.Parallel()
+
+	server, hits := imageServer(t, solidPNG(t, 300, 150))
+	fetcher := imaging.NewFetcher(&config.AppSettings{CocktailImageMaxDimension: 60, CocktailImageCacheEntries: 4})
+
+	img, err := fetcher.Fetch(context.Background(), server.URL+"/pegu-club.png")
+	require.NoError(t, err)
+	require.Equal(t, "image/png", img.MIMEType)
+
+	decoded, err := png.Decode(bytes.NewReader(img.Data))
+	require.NoError(t, err)
+	require.Equal(t, image.Rect(0, 0, 60, 30), decoded.Bounds())
+
+	_, err = fetcher.Fetch(context.Background(), server.URL+"/pegu-club.png")
+	require.NoError(t, err)
+	require.Equal(t, int32(1), hits.Load())
+}
+
+func Test_fetcher_reuses_disk_cache_across_instances(t *testing.T) {
+	t.Parallel()
+
+	server, hits := imageServer(t, solidPNG(t, 100, 100))
+	settings := &config.AppSettings{CocktailImageMaxDimension: 50, CocktailImageCacheEntries: 4, CocktailImageCacheDir: t.TempDir()}
+
+	first, err := imaging.NewFetcher(settings).Fetch(context.Background(), server.URL+"/bijou.png")
+	require.NoError(t, err)
+
+	second, err := imaging.NewFetcher(settings).Fetch(context.Background(), server.URL+"/bijou.png")
+	require.NoError(t, err)
+
+	require.Equal(t, first.Data, second.Data)
+	require.Equal(t, "image/png", second.MIMEType)
+	require.Equal(t, int32(1), hits.Load())
+}
+
+// ageFiles moves the modification time of every file in the directory a minute into the past, so the files written
+// or read by the next fetch are unambiguously the most recently used.
+func ageFiles(t *testing.T, dir string) {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	for _, entry := range entries {
+		info, err := entry.Info()
+		require.NoError(t, err)
+
+		aged := info.ModTime().Add(-time.Minute)
+		require.NoError(t, os.Chtimes(filepath.Join(dir, entry.Name()), aged, aged))
+	}
+}
+
+func Test_fetcher_prunes_least_recently_used_images_from_disk(t *testing.T) {
+	t.Parallel()
+
+	server, hits := imageServer(t, solidPNG(t, 100, 100))
+
+	// Every image downscales to the same data, so the cache can be bounded to exactly two of them.
+	probe, err := imaging.NewFetcher(&config.AppSettings{CocktailImageMaxDimension: 50}).Fetch(context.Background(), server.URL+"/probe.png")
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	settings := &config.AppSettings{
+		CocktailImageMaxDimension:     50,
+		CocktailImageCacheDir:         dir,
+		CocktailImageCacheDirMaxBytes: int64(2 * len(probe.Data)),
+	}
+
+	// A fresh fetcher for every fetch bypasses the in-memory cache so each one goes through the disk cache.
+	fetch := func(name string) {
+		_, err := imaging.NewFetcher(settings).Fetch(context.Background(), server.URL+"/"+name+".png")
+		require.NoError(t, err)
+		ageFiles(t, dir)
+	}
+
+	fetch("negroni")
+	fetch("bijou")
+	fetch("negroni")
+	require.Equal(t, int32(3), hits.Load())
+
+	// act
+	fetch("gimlet")
+
+	// assert
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	fetch("negroni")
+	require.Equal(t, int32(4), hits.Load(), "the recently read negroni image should still be cached")
+
+	fetch("bijou")
+	require.Equal(t, int32(5), hits.Load(), "the least recently used bijou image should have been removed")
+}
+
+func Test_fetcher_returns_error_on_failed_download(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	fetcher := imaging.NewFetcher(&config.AppSettings{CocktailImageMaxDimension: 50})
+
+	img, err := fetcher.Fetch(context.Background(), server.URL+"/missing.png")
+	require.Nil(t, img)
+	require.ErrorContains(t, err, "status 404")
+}
+
+func Test_fetcher_rejects_images_over_the_pixel_budget(t *testing.T) {
+	t.Parallel()
+
+	// A GIF header declaring a 60000x60000 screen, which is all that is read before the image is rejected.
+	header := []byte("GIF89a")
+	header = binary.LittleEndian.AppendUint16(header, 60000)
+	header = binary.LittleEndian.AppendUint16(header, 60000)
+	header = append(header, 0, 0, 0)
+
+	server, _ := imageServer(t, header)
+	fetcher := imaging.NewFetcher(&config.AppSettings{CocktailImageMaxDimension: 50, CocktailImageCacheEntries: 4})
+
+	img, err := fetcher.Fetch(context.Background(), server.URL+"/bomb.gif")
+	require.Nil(t, img)
+	require.ErrorContains(t, err, "image of 60000x60000 pixels exceeds the 24 megapixel limit")
+}
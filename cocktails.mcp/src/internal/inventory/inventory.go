@@ -0,0 +1,229 @@
+// Package inventory matches cocktail recipes against the ingredients stocked in a bar.  Recipes are ranked
+// by how many of their required ingredients are on hand, with optional ingredients reported but never
+// counted against a recipe.  Ingredients related through the ingredient catalog's ParentId can stand in
+// for one another, so a bottle of London dry gin satisfies a recipe calling for gin and vice versa.  Siblings
+// sharing a parent, such as bourbon and rye, are different ingredients and are only suggested as substitutes.
+package inventory
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Bottle is an ingredient stocked in a bar.
+type Bottle struct {
+	ID       string
+	Name     string
+	ParentID string
+}
+
+// RecipeIngredient is an ingredient called for by a recipe.  ParentID is optional and, when known, allows
+// the ingredient to be substituted by its parent or by its siblings in the ingredient catalog.
+type RecipeIngredient struct {
+	ID       string
+	Name     string
+	ParentID string
+	Optional bool
+}
+
+// Recipe is a cocktail recipe to match against a bar.
+type Recipe struct {
+	ID          string
+	Title       string
+	Rating      float64
+	Ingredients []RecipeIngredient
+}
+
+// Substitution is a stocked bottle used in place of the ingredient a recipe calls for.
+type Substitution struct {
+	Ingredient string `json:"ingredient"`
+	Use        string `json:"use"`
+}
+
+// Result is how well a recipe can be made from a bar.
+type Result struct {
+	ID               string         `json:"id"`
+	Title            string         `json:"title"`
+	Rating           float64        `json:"rating,omitempty"`
+	CanMake          bool           `json:"canMake"`
+	RequiredCount    int            `json:"requiredCount"`
+	OnHandCount      int            `json:"onHandCount"`
+	Coverage         float64        `json:"coverage"`
+	Missing          []string       `json:"missing,omitempty"`
+	MissingOptional  []string       `json:"missingOptional,omitempty"`
+	Substitutions    []Substitution `json:"substitutions,omitempty"`
+	IngredientsCount int            `json:"ingredientsCount"`
+	// Substitutes are stocked siblings that might stand in for missing ingredients, which still count as missing.
+	Substitutes []Substitution `json:"substitutes,omitempty"`
+}
+
+// Shelf indexes the bottles stocked in a bar by their id and parent id.
+type Shelf struct {
+	byID     map[string]Bottle
+	byParent map[string][]Bottle
+}
+
+// NewShelf creates a Shelf holding the bottles.
+func NewShelf(bottles []Bottle) *Shelf {
+	shelf := &Shelf{
+		byID:     make(map[string]Bottle, len(bottles)),
+		byParent: map[string][]Bottle{},
+	}
+
+	for _, bottle := range bottles {
+		id := normalizeID(bottle.ID)
+		if id == "" {
+			continue
+		}
+
+		shelf.byID[id] = bottle
+		if parentID := normalizeID(bottle.ParentID); parentID != "" {
+			shelf.byParent[parentID] = append(shelf.byParent[parentID], bottle)
+		}
+	}
+
+	return shelf
+}
+
+// Len returns the number of bottles on the shelf.
+func (shelf *Shelf) Len() int {
+	return len(shelf.byID)
+}
+
+// Has reports whether the bottle with the id is on the shelf.
+func (shelf *Shelf) Has(id string) bool {
+	_, ok := shelf.byID[normalizeID(id)]
+	return ok
+}
+
+// Find returns the bottle that satisfies the ingredient, checking in order for the ingredient itself, a more
+// specific bottle whose parent is the ingredient and finally the ingredient's parent.  Exact reports whether the
+// bottle is the ingredient itself rather than a related bottle.
+func (shelf *Shelf) Find(ingredient RecipeIngredient) (bottle Bottle, exact bool, found bool) {
+	id := normalizeID(ingredient.ID)
+	if id == "" {
+		return Bottle{}, false, false
+	}
+
+	if bottle, ok := shelf.byID[id]; ok {
+		return bottle, true, true
+	}
+
+	if children := shelf.byParent[id]; len(children) > 0 {
+		return children[0], false, true
+	}
+
+	parentID := normalizeID(ingredient.ParentID)
+	if parentID == "" || parentID == id {
+		return Bottle{}, false, false
+	}
+
+	if bottle, ok := shelf.byID[parentID]; ok {
+		return bottle, false, true
+	}
+
+	return Bottle{}, false, false
+}
+
+// Substitute returns a bottle sharing the ingredient's parent, such as bourbon for rye.  A sibling is a different
+// ingredient rather than a more or less specific bottle of the same one, so it never satisfies the ingredient and
+// is only offered as something that might stand in for it.
+func (shelf *Shelf) Substitute(ingredient RecipeIngredient) (Bottle, bool) {
+	id := normalizeID(ingredient.ID)
+	parentID := normalizeID(ingredient.ParentID)
+	if id == "" || parentID == "" || parentID == id {
+		return Bottle{}, false
+	}
+
+	for _, sibling := range shelf.byParent[parentID] {
+		if normalizeID(sibling.ID) != id {
+			return sibling, true
+		}
+	}
+
+	return Bottle{}, false
+}
+
+// Match works out how well the recipe can be made from the shelf.
+func (shelf *Shelf) Match(recipe Recipe) Result {
+	result := Result{
+		ID:               recipe.ID,
+		Title:            recipe.Title,
+		Rating:           recipe.Rating,
+		IngredientsCount: len(recipe.Ingredients),
+	}
+
+	for _, ingredient := range recipe.Ingredients {
+		bottle, exact, found := shelf.Find(ingredient)
+
+		if found && !exact {
+			result.Substitutions = append(result.Substitutions, Substitution{Ingredient: ingredient.Name, Use: bottle.Name})
+		}
+
+		if ingredient.Optional {
+			if !found {
+				result.MissingOptional = append(result.MissingOptional, ingredient.Name)
+			}
+			continue
+		}
+
+		result.RequiredCount++
+		if found {
+			result.OnHandCount++
+			continue
+		}
+
+		result.Missing = append(result.Missing, ingredient.Name)
+		if substitute, ok := shelf.Substitute(ingredient); ok {
+			result.Substitutes = append(result.Substitutes, Substitution{Ingredient: ingredient.Name, Use: substitute.Name})
+		}
+	}
+
+	result.CanMake = len(result.Missing) == 0
+	result.Coverage = 1
+	if result.RequiredCount > 0 {
+		result.Coverage = float64(result.OnHandCount) / float64(result.RequiredCount)
+	}
+
+	return result
+}
+
+// Rank matches each recipe against the shelf and orders the results so the recipes that can be made come
+// first, followed by those missing the fewest required ingredients.  Ties are broken by the number of missing
+// ingredients with a substitute on the shelf, then by the share of required ingredients on hand, then by rating
+// and finally by title.
+func (shelf *Shelf) Rank(recipes []Recipe) []Result {
+	results := make([]Result, 0, len(recipes))
+	for _, recipe := range recipes {
+		results = append(results, shelf.Match(recipe))
+	}
+
+	slices.SortStableFunc(results, func(a, b Result) int {
+		return cmp.Or(
+			cmp.Compare(len(a.Missing), len(b.Missing)),
+			cmp.Compare(len(b.Substitutes), len(a.Substitutes)),
+			cmp.Compare(b.Coverage, a.Coverage),
+			cmp.Compare(b.Rating, a.Rating),
+			strings.Compare(a.Title, b.Title),
+		)
+	})
+
+	return results
+}
+
+// IsOptional reports whether an ingredient requirement, such as the cocktails API 'Required' or 'Optional'
+// requirement types, marks the ingredient as optional.  Any other value is treated as required.
+func IsOptional(requirement any) bool {
+	if requirement == nil {
+		return false
+	}
+
+	return strings.EqualFold(strings.TrimSpace(fmt.Sprint(requirement)), "optional")
+}
+
+// normalizeID trims and lowercases an ingredient id so ids from the catalog, recipes and users compare equal.
+func normalizeID(id string) string {
+	return strings.ToLower(strings.TrimSpace(id))
+}
@@ -0,0 +1,148 @@
+package inventory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/inventory"
+)
+
+func homeBar() *inventory.Shelf {
+	return inventory.NewShelf([]inventory.Bottle{
+		{ID: "london-dry-gin", Name: "London Dry Gin", ParentID: "gin"},
+		{ID: "lime-juice", Name: "Lime Juice"},
+		{ID: "simple-syrup", Name: "Simple Syrup"},
+		{ID: "bourbon", Name: "Bourbon", ParentID: "whiskey"},
+	})
+}
+
+func Test_find_matches_exact_and_parent_substitutions(t *testing.T) {
+	t.Parallel()
+
+	shelf := homeBar()
+
+	tests := []struct {
+		name       string
+		ingredient inventory.RecipeIngredient
+		bottle     string
+		exact      bool
+		found      bool
+	}{
+		{"exact id", inventory.RecipeIngredient{ID: "Lime-Juice"}, "Lime Juice", true, true},
+		{"specific bottle for generic ingredient", inventory.RecipeIngredient{ID: "gin"}, "London Dry Gin", false, true},
+		{"sibling sharing a parent", inventory.RecipeIngredient{ID: "rye", ParentID: "whiskey"}, "", false, false},
+		{"unrelated ingredient", inventory.RecipeIngredient{ID: "campari", ParentID: "amaro"}, "", false, false},
+		{"blank id", inventory.RecipeIngredient{Name: "Ice"}, "", false, false},
+	}
+
+	for _, test := range tests {
+		bottle, exact, found := shelf.Find(test.ingredient)
+		require.Equal(t, test.found, found, test.name)
+		require.Equal(t, test.exact, exact, test.name)
+		require.Equal(t, test.bottle, bottle.Name, test.name)
+	}
+
+	generic := inventory.NewShelf([]inventory.Bottle{{ID: "gin", Name: "Gin"}})
+	bottle, exact, found := generic.Find(inventory.RecipeIngredient{ID: "old-tom-gin", ParentID: "gin"})
+	require.True(t, found)
+	require.False(t, exact)
+	require.Equal(t, "Gin", bottle.Name)
+}
+
+func Test_substitute_offers_a_sibling_sharing_the_parent(t *testing.T) {
+	t.Parallel()
+
+	shelf := homeBar()
+
+	bottle, ok := shelf.Substitute(inventory.RecipeIngredient{ID: "rye", ParentID: "whiskey"})
+	require.True(t, ok)
+	require.Equal(t, "Bourbon", bottle.Name)
+
+	_, ok = shelf.Substitute(inventory.RecipeIngredient{ID: "bourbon", ParentID: "whiskey"})
+	require.False(t, ok)
+
+	_, ok = shelf.Substitute(inventory.RecipeIngredient{ID: "rye"})
+	require.False(t, ok)
+}
+
+func Test_match_counts_ingredients_with_a_substitute_as_missing(t *testing.T) {
+	t.Parallel()
+
+	result := homeBar().Match(inventory.Recipe{
+		ID:    "old-pal",
+		Title: "Old Pal",
+		Ingredients: []inventory.RecipeIngredient{
+			{ID: "rye", Name: "Rye", ParentID: "whiskey"},
+			{ID: "lime-juice", Name: "Lime Juice"},
+		},
+	})
+
+	require.False(t, result.CanMake)
+	require.Equal(t, 1, result.OnHandCount)
+	require.Equal(t, []string{"Rye"}, result.Missing)
+	require.Empty(t, result.Substitutions)
+	require.Equal(t, []inventory.Substitution{{Ingredient: "Rye", Use: "Bourbon"}}, result.Substitutes)
+}
+
+func Test_match_ignores_missing_optional_ingredients(t *testing.T) {
+	t.Parallel()
+
+	result := homeBar().Match(inventory.Recipe{
+		ID:    "gimlet",
+		Title: "Gimlet",
+		Ingredients: []inventory.RecipeIngredient{
+			{ID: "gin", Name: "Gin"},
+			{ID: "lime-juice", Name: "Lime Juice"},
+			{ID: "simple-syrup", Name: "Simple Syrup"},
+			{ID: "lime-wheel", Name: "Lime Wheel", Optional: true},
+		},
+	})
+
+	require.True(t, result.CanMake)
+	require.Equal(t, 3, result.RequiredCount)
+	require.Equal(t, 3, result.OnHandCount)
+	require.Equal(t, float64(1), result.Coverage)
+	require.Equal(t, []string{"Lime Wheel"}, result.MissingOptional)
+	require.Equal(t, []inventory.Substitution{{Ingredient: "Gin", Use: "London Dry Gin"}}, result.Substitutions)
+}
+
+func Test_rank_orders_by_missing_then_coverage_then_rating(t *testing.T) {
+	t.Parallel()
+
+	recipes := []inventory.Recipe{
+		{ID: "negroni", Title: "Negroni", Rating: 5, Ingredients: []inventory.RecipeIngredient{
+			{ID: "gin"}, {ID: "campari", Name: "Campari"}, {ID: "sweet-vermouth", Name: "Sweet Vermouth"},
+		}},
+		{ID: "gin-sour", Title: "Gin Sour", Rating: 3, Ingredients: []inventory.RecipeIngredient{
+			{ID: "gin"}, {ID: "lime-juice"}, {ID: "simple-syrup"},
+		}},
+		{ID: "southside", Title: "Southside", Rating: 4, Ingredients: []inventory.RecipeIngredient{
+			{ID: "gin"}, {ID: "lime-juice"}, {ID: "simple-syrup"}, {ID: "mint", Name: "Mint"},
+		}},
+		{ID: "gimlet", Title: "Gimlet", Rating: 4, Ingredients: []inventory.RecipeIngredient{
+			{ID: "gin"}, {ID: "lime-juice"}, {ID: "simple-syrup"},
+		}},
+	}
+
+	results := homeBar().Rank(recipes)
+
+	ids := make([]string, 0, len(results))
+	for _, result := range results {
+		ids = append(ids, result.ID)
+	}
+
+	require.Equal(t, []string{"gimlet", "gin-sour", "southside", "negroni"}, ids)
+	require.Equal(t, []string{"Mint"}, results[2].Missing)
+	require.Equal(t, []string{"Campari", "Sweet Vermouth"}, results[3].Missing)
+}
+
+func Test_is_optional_accepts_requirement_values(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, inventory.IsOptional("Optional"))
+	require.True(t, inventory.IsOptional("optional"))
+	require.False(t, inventory.IsOptional("Required"))
+	require.False(t, inventory.IsOptional("none"))
+	require.False(t, inventory.IsOptional(nil))
+}
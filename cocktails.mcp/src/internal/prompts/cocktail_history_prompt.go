@@ -0,0 +1,39 @@
+package prompts
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+// CocktailHistoryPrompt is an MCP prompt that explains the history of a cocktail.
+var CocktailHistoryPrompt = mcp.NewPrompt(
+	"explain_cocktail_history",
+	mcp.WithPromptDescription("Explain the origin and history of a cocktail using its Cezzis.com recipe."),
+	mcp.WithArgument("cocktail",
+		mcp.RequiredArgument(),
+		mcp.ArgumentDescription("The name or Cezzis.com id of the cocktail, for example 'Negroni' or 'negroni'."),
+	),
+)
+
+// HandleCocktailHistoryPrompt builds the explain_cocktail_history prompt.
+func HandleCocktailHistoryPrompt(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	cocktail, err := requirePromptArgument(request, "cocktail")
+	if err != nil {
+		return nil, err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP Getting cocktail history prompt: " + cocktail)
+
+	return userPrompt(
+		"Explain the history of "+cocktail,
+		"Tell me the story of the "+cocktail+" cocktail.",
+		"",
+		"- Use search_cocktails to find the cocktail if you do not already know its id, then use get_cocktail with format 'full' to read its complete recipe and historical notes.",
+		"- Explain where and when it originated, who is credited with creating it and how the recipe has evolved, based on the recipe content.",
+		"- Use get_related_cocktails to suggest a few variations or cocktails from the same family.",
+		"- Clearly separate facts from the recipe content from general knowledge and include the Cezzis.com link.",
+	), nil
+}
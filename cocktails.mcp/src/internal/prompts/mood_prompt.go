@@ -0,0 +1,47 @@
+package prompts
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+// MoodPrompt is an MCP prompt that picks a cocktail to suit the user's mood.
+var MoodPrompt = mcp.NewPrompt(
+	"pick_drink_for_mood",
+	mcp.WithPromptDescription("Pick a cocktail for tonight that suits your mood."),
+	mcp.WithArgument("mood",
+		mcp.RequiredArgument(),
+		mcp.ArgumentDescription("How you are feeling or the vibe of the evening, for example 'celebratory', 'cozy' or 'winding down'."),
+	),
+	mcp.WithArgument("spirit",
+		mcp.ArgumentDescription("An optional preferred base spirit, for example 'gin' or 'bourbon'."),
+	),
+)
+
+// HandleMoodPrompt builds the pick_drink_for_mood prompt.
+func HandleMoodPrompt(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	mood, err := requirePromptArgument(request, "mood")
+	if err != nil {
+		return nil, err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP Getting mood prompt: " + mood)
+
+	ask := "I'm in a " + mood + " mood tonight.  Pick a cocktail for me."
+	if spirit := promptArgument(request, "spirit"); spirit != "" {
+		ask = "I'm in a " + mood + " mood tonight and feel like " + spirit + ".  Pick a cocktail for me."
+	}
+
+	return userPrompt(
+		"Pick a drink for a "+mood+" mood",
+		ask,
+		"",
+		"- Use search_cocktails with a free text description of the mood to find candidates.",
+		"- If I am signed in, use list_favorite_cocktails and list_my_cocktail_ratings to favor drinks I already enjoy.",
+		"- Recommend one cocktail with a short explanation of why it fits, plus two alternatives.",
+		"- Use get_cocktail for the recommendation and include its ingredients and Cezzis.com link.",
+	), nil
+}
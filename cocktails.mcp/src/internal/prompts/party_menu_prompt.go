@@ -0,0 +1,57 @@
+package prompts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+// PartyMenuPrompt is an MCP prompt that plans a cocktail menu for a party.
+var PartyMenuPrompt = mcp.NewPrompt(
+	"plan_party_menu",
+	mcp.WithPromptDescription("Plan a balanced cocktail menu for a party or gathering using Cezzis.com recipes."),
+	mcp.WithArgument("occasion",
+		mcp.RequiredArgument(),
+		mcp.ArgumentDescription("The occasion or theme of the party, for example 'summer backyard barbecue' or 'New Year's Eve'."),
+	),
+	mcp.WithArgument("guests",
+		mcp.ArgumentDescription("The approximate number of guests."),
+	),
+	mcp.WithArgument("drinks",
+		mcp.ArgumentDescription("How many different cocktails the menu should include.  Defaults to 4."),
+	),
+)
+
+// HandlePartyMenuPrompt builds the plan_party_menu prompt.
+func HandlePartyMenuPrompt(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	occasion, err := requirePromptArgument(request, "occasion")
+	if err != nil {
+		return nil, err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP Getting party menu prompt: " + occasion)
+
+	drinks := promptArgument(request, "drinks")
+	if drinks == "" {
+		drinks = "4"
+	}
+
+	guests := "an unspecified number of guests"
+	if value := promptArgument(request, "guests"); value != "" {
+		guests = value + " guests"
+	}
+
+	return userPrompt(
+		"Plan a cocktail menu for "+occasion,
+		fmt.Sprintf("Plan a cocktail menu of %s drinks for a %s with %s.", drinks, occasion, guests),
+		"",
+		"- Use the search_cocktails tool to find candidate cocktails that suit the occasion, and get_cocktail_collection or list_cocktail_collections when a curated seasonal or holiday collection fits.",
+		"- Balance the menu across base spirits, flavor profiles and strength, and include at least one low or no alcohol option.",
+		"- Use get_cocktail for each chosen drink to confirm the ingredients, then explain briefly why it fits the occasion.",
+		"- Finish with a combined shopping list from the generate_shopping_list tool, with enough servings of each drink for the guests.",
+		"- Only recommend cocktails returned by the tools and include each cocktail's Cezzis.com link.",
+	), nil
+}
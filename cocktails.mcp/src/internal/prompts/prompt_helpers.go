@@ -0,0 +1,36 @@
+// Package prompts provides MCP prompt templates for the Cezzi Cocktails MCP server.
+// Prompts give MCP clients ready-made, parameterized starting points for common
+// cocktail workflows and steer the model toward the server's existing tools.
+package prompts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// promptArgument returns the trimmed value of an optional prompt argument.
+func promptArgument(request mcp.GetPromptRequest, name string) string {
+	return strings.TrimSpace(request.Params.Arguments[name])
+}
+
+// requirePromptArgument returns the trimmed value of a required prompt argument or an error if it is missing.
+func requirePromptArgument(request mcp.GetPromptRequest, name string) (string, error) {
+	value := promptArgument(request, name)
+	if value == "" {
+		return "", fmt.Errorf("required argument %q not found", name)
+	}
+
+	return value, nil
+}
+
+// userPrompt builds a prompt result made up of a single user message.
+func userPrompt(description string, lines ...string) *mcp.GetPromptResult {
+	return mcp.NewGetPromptResult(
+		description,
+		[]mcp.PromptMessage{
+			mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(strings.Join(lines, "\n"))),
+		},
+	)
+}
@@ -0,0 +1,117 @@
+package prompts_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/prompts"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+)
+
+func promptText(t *testing.T, result *mcp.GetPromptResult) string {
+	t.Helper()
+
+	require.Len(t, result.Messages, 1)
+	require.Equal(t, mcp.RoleUser, result.Messages[0].Role)
+
+	text, ok := result.Messages[0].Content.(mcp.TextContent)
+	require.True(t, ok, "Content should be of type TextContent")
+
+	return text.Text
+}
+
+func getPromptRequest(arguments map[string]string) mcp.GetPromptRequest {
+	return mcp.GetPromptRequest{
+		Params: mcp.GetPromptParams{
+			Arguments: arguments,
+		},
+	}
+}
+
+func Test_prompts_reference_existing_tools(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		handle    func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error)
+		arguments map[string]string
+		contains  []string
+	}{
+		{
+			name:      "plan_party_menu",
+			handle:    prompts.HandlePartyMenuPrompt,
+			arguments: map[string]string{"occasion": "summer barbecue", "guests": "12"},
+			contains:  []string{"4 drinks", "summer barbecue with 12 guests", "search_cocktails", "get_cocktail"},
+		},
+		{
+			name:      "what_can_i_make",
+			handle:    prompts.HandleWhatCanIMakePrompt,
+			arguments: map[string]string{"ingredients": "gin, campari, sweet vermouth"},
+			contains:  []string{"gin, campari, sweet vermouth", "get_ingredient_filters", "search_cocktails"},
+		},
+		{
+			name:      "explain_cocktail_history",
+			handle:    prompts.HandleCocktailHistoryPrompt,
+			arguments: map[string]string{"cocktail": "Negroni"},
+			contains:  []string{"Negroni", "get_cocktail", "get_related_cocktails"},
+		},
+		{
+			name:      "pick_drink_for_mood",
+			handle:    prompts.HandleMoodPrompt,
+			arguments: map[string]string{"mood": "cozy", "spirit": "bourbon"},
+			contains:  []string{"cozy mood", "feel like bourbon", "search_cocktails", "list_favorite_cocktails"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			t.Parallel()
+			testutils.LoadEnvironment("..", "..")
+
+			// act
+			result, err := tt.handle(context.Background(), getPromptRequest(tt.arguments))
+
+			// assert
+			require.NoError(t, err)
+			require.NotEmpty(t, result.Description)
+
+			text := promptText(t, result)
+			for _, expected := range tt.contains {
+				require.Contains(t, text, expected)
+			}
+		})
+	}
+}
+
+func Test_prompts_return_error_on_missing_required_argument(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		handle   func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error)
+		argument string
+	}{
+		{handle: prompts.HandlePartyMenuPrompt, argument: "occasion"},
+		{handle: prompts.HandleWhatCanIMakePrompt, argument: "ingredients"},
+		{handle: prompts.HandleCocktailHistoryPrompt, argument: "cocktail"},
+		{handle: prompts.HandleMoodPrompt, argument: "mood"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.argument, func(t *testing.T) {
+			// arrange
+			t.Parallel()
+			testutils.LoadEnvironment("..", "..")
+
+			// act
+			result, err := tt.handle(context.Background(), getPromptRequest(map[string]string{tt.argument: "  "}))
+
+			// assert
+			require.Nil(t, result)
+			require.ErrorContains(t, err, "required argument \""+tt.argument+"\" not found")
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package prompts
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+// WhatCanIMakePrompt is an MCP prompt that finds cocktails that can be made from the ingredients on hand.
+var WhatCanIMakePrompt = mcp.NewPrompt(
+	"what_can_i_make",
+	mcp.WithPromptDescription("Find cocktails that can be made with the ingredients you have on hand."),
+	mcp.WithArgument("ingredients",
+		mcp.RequiredArgument(),
+		mcp.ArgumentDescription("A comma separated list of the spirits, liqueurs, mixers and garnishes you have available."),
+	),
+)
+
+// HandleWhatCanIMakePrompt builds the what_can_i_make prompt.
+func HandleWhatCanIMakePrompt(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+	ingredients, err := requirePromptArgument(request, "ingredients")
+	if err != nil {
+		return nil, err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP Getting what can I make prompt")
+
+	return userPrompt(
+		"Find cocktails I can make with what I have",
+		"I have the following ingredients on hand: "+ingredients+".",
+		"",
+		"- Use get_ingredient_filters to map my ingredients to the search filters, then use search_cocktails with those filters to find matching cocktails.",
+		"- Use get_cocktail to check each candidate's full ingredient list.",
+		"- List the cocktails I can make right now first, then those missing only one ingredient, naming the missing ingredient.",
+		"- If I have stocked a bar in my Cezzis.com account, the what_can_i_make tool ranks cocktails against it directly.",
+		"- Only recommend cocktails returned by the tools and include each cocktail's Cezzis.com link.",
+	), nil
+}
@@ -0,0 +1,143 @@
+// Package rendering renders Cezzis.com cocktail data as Markdown for MCP clients.
+// A consistent recipe card keeps answers uniform across models and is far smaller
+// than the raw cocktail JSON.
+package rendering
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+)
+
+// CocktailURL returns the Cezzis.com link for the cocktail id.
+func CocktailURL(cocktailID string) string {
+	return fmt.Sprintf("%s/cocktails/%s", config.GetAppSettings().CezzisBaseURL, cocktailID)
+}
+
+// RatingStars renders a 0-5 rating as filled and empty stars, rounded to the nearest whole star.
+func RatingStars(rating float64) string {
+	filled := int(math.Round(math.Max(0, math.Min(5, rating))))
+	return strings.Repeat("★", filled) + strings.Repeat("☆", 5-filled)
+}
+
+// RecipeCard renders the cocktail as a compact Markdown recipe card with its title, rating, ingredients,
+// numbered steps, glassware and Cezzis.com link.
+func RecipeCard(cocktail *cocktailsapi.CocktailModel) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# %s\n\n", cocktail.Title)
+
+	if cocktail.DescriptiveTitle != "" && cocktail.DescriptiveTitle != cocktail.Title {
+		fmt.Fprintf(&sb, "_%s_\n\n", cocktail.DescriptiveTitle)
+	}
+
+	if cocktail.Rating.RatingCount > 0 {
+		fmt.Fprintf(&sb, "%s %.1f / 5 (%d ratings)\n\n", RatingStars(cocktail.Rating.Rating), cocktail.Rating.Rating, cocktail.Rating.RatingCount)
+	} else {
+		sb.WriteString("☆☆☆☆☆ Not yet rated\n\n")
+	}
+
+	if cocktail.Description != "" {
+		fmt.Fprintf(&sb, "%s\n\n", cocktail.Description)
+	}
+
+	if details := cardDetails(cocktail); len(details) > 0 {
+		fmt.Fprintf(&sb, "%s\n\n", strings.Join(details, " · "))
+	}
+
+	if len(cocktail.Ingredients) > 0 {
+		sb.WriteString("## Ingredients\n\n")
+
+		for _, ingredient := range cocktail.Ingredients {
+			display := ingredient.Display
+			if display == "" {
+				display = ingredient.Name
+			}
+
+			fmt.Fprintf(&sb, "- %s\n", display)
+		}
+
+		sb.WriteString("\n")
+	}
+
+	if steps := orderedSteps(cocktail.Instructions); len(steps) > 0 {
+		sb.WriteString("## Steps\n\n")
+
+		for i, step := range steps {
+			fmt.Fprintf(&sb, "%d. %s\n", i+1, step.Display)
+		}
+
+		sb.WriteString("\n")
+	}
+
+	fmt.Fprintf(&sb, "[View %s on Cezzis.com](%s)\n", cocktail.Title, CocktailURL(cocktail.Id))
+
+	return sb.String()
+}
+
+// FullRecipe renders the recipe card followed by the complete descriptive recipe content, including its history.
+func FullRecipe(cocktail *cocktailsapi.CocktailModel) string {
+	card := RecipeCard(cocktail)
+
+	content := strings.TrimSpace(cocktail.Content)
+	if content == "" {
+		return card
+	}
+
+	return card + "\n## About\n\n" + content + "\n"
+}
+
+// cardDetails returns the short serving, glassware and prep time facts shown beneath the description.
+func cardDetails(cocktail *cocktailsapi.CocktailModel) []string {
+	var details []string
+
+	if glassware := glasswareNames(cocktail.Glassware); len(glassware) > 0 {
+		details = append(details, "**Glassware:** "+strings.Join(glassware, ", "))
+	}
+
+	if cocktail.Serves > 0 {
+		details = append(details, fmt.Sprintf("**Serves:** %d", cocktail.Serves))
+	}
+
+	if cocktail.PrepTimeMinutes > 0 {
+		details = append(details, fmt.Sprintf("**Prep:** %d min", cocktail.PrepTimeMinutes))
+	}
+
+	if cocktail.IsIba {
+		details = append(details, "**IBA official**")
+	}
+
+	return details
+}
+
+// glasswareNames returns the readable names of the glassware, which the API models as untyped enum values.
+func glasswareNames(glassware []cocktailsapi.GlasswareTypeModel) []string {
+	names := make([]string, 0, len(glassware))
+	for _, glass := range glassware {
+		if name, ok := glass.(string); ok && strings.TrimSpace(name) != "" {
+			names = append(names, strings.TrimSpace(name))
+		}
+	}
+
+	return names
+}
+
+// orderedSteps returns the non-empty instruction steps sorted by their order.
+func orderedSteps(instructions []cocktailsapi.InstructionStepModel) []cocktailsapi.InstructionStepModel {
+	steps := make([]cocktailsapi.InstructionStepModel, 0, len(instructions))
+	for _, step := range instructions {
+		if strings.TrimSpace(step.Display) != "" {
+			steps = append(steps, step)
+		}
+	}
+
+	slices.SortStableFunc(steps, func(a, b cocktailsapi.InstructionStepModel) int {
+		return int(a.Order - b.Order)
+	})
+
+	return steps
+}
@@ -0,0 +1,72 @@
+package rendering_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/rendering"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+)
+
+func peguClub() *cocktailsapi.CocktailModel {
+	return &cocktailsapi.CocktailModel{
+		Id:               "pegu-club",
+		Title:            "Pegu Club",
+		DescriptiveTitle: "The Pegu Club Cocktail",
+		Description:      "A gin sour from colonial Burma.",
+		Glassware:        []cocktailsapi.GlasswareTypeModel{"Coupe"},
+		Serves:           1,
+		Ingredients: []cocktailsapi.IngredientModel{
+			{Name: "Gin", Display: "2 oz Gin"},
+			{Name: "Orange Curacao"},
+		},
+		Instructions: []cocktailsapi.InstructionStepModel{
+			{Order: 2, Display: "Strain into a chilled coupe."},
+			{Order: 1, Display: "Shake with ice."},
+		},
+		Rating:  cocktailsapi.CocktailRatingModel{Rating: 4.4, RatingCount: 12},
+		Content: "Named for the Pegu Club in Rangoon.",
+	}
+}
+
+func Test_recipe_card_renders_title_rating_ingredients_steps_glassware_and_link(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+
+	// act
+	card := rendering.RecipeCard(peguClub())
+
+	// assert
+	require.Contains(t, card, "# Pegu Club\n")
+	require.Contains(t, card, "_The Pegu Club Cocktail_")
+	require.Contains(t, card, "★★★★☆ 4.4 / 5 (12 ratings)")
+	require.Contains(t, card, "**Glassware:** Coupe")
+	require.Contains(t, card, "- 2 oz Gin\n- Orange Curacao\n")
+	require.Contains(t, card, "1. Shake with ice.\n2. Strain into a chilled coupe.\n")
+	require.Contains(t, card, "(http://localhost:4003/cocktails/pegu-club)")
+	require.NotContains(t, card, "Rangoon")
+}
+
+func Test_full_recipe_appends_descriptive_content(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+
+	// act
+	recipe := rendering.FullRecipe(peguClub())
+
+	// assert
+	require.Contains(t, recipe, "## Steps")
+	require.Contains(t, recipe, "## About\n\nNamed for the Pegu Club in Rangoon.\n")
+}
+
+func Test_rating_stars_rounds_and_clamps(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "☆☆☆☆☆", rendering.RatingStars(0))
+	require.Equal(t, "★★★☆☆", rendering.RatingStars(2.5))
+	require.Equal(t, "★★★★★", rendering.RatingStars(7))
+}
@@ -0,0 +1,108 @@
+package rendering
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/shopping"
+)
+
+// shoppingListCSVHeader is the header row of a shopping list rendered as CSV.
+var shoppingListCSVHeader = []string{"category", "ingredient", "amount", "unit", "count", "display", "cocktails", "in_bar", "substitute"}
+
+// ShoppingListMarkdown renders the shopping list as Markdown, with a checklist of the ingredients to buy under a
+// heading for each category, followed by the ingredients already stocked in the bar and the cocktails shopped for.
+// Ingredients to buy that have a stocked substitute in the bar name it after the cocktails that use them.
+func ShoppingListMarkdown(list *shopping.List, title string) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# %s\n\n", title)
+
+	if len(list.ToBuy) == 0 {
+		sb.WriteString("Nothing to buy, everything is already in your bar.\n\n")
+	}
+
+	for _, group := range list.ToBuy {
+		fmt.Fprintf(&sb, "## %s\n\n", group.Category)
+		for _, item := range group.Items {
+			fmt.Fprintf(&sb, "- [ ] %s _(%s)_", item.Display, strings.Join(item.Cocktails, ", "))
+			if item.Substitute != "" {
+				fmt.Fprintf(&sb, ", or use %s from your bar", item.Substitute)
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(list.InBar) > 0 {
+		sb.WriteString("## Already in your bar\n\n")
+		for _, item := range list.InBar {
+			if strings.EqualFold(item.InBar, item.Name) {
+				fmt.Fprintf(&sb, "- [x] %s\n", item.Display)
+			} else {
+				fmt.Fprintf(&sb, "- [x] %s, using %s\n", item.Display, item.InBar)
+			}
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("## Cocktails\n\n")
+	for _, cocktail := range list.Cocktails {
+		fmt.Fprintf(&sb, "- [%s](%s) x %s\n", cocktail.Title, CocktailURL(cocktail.ID), strconv.FormatFloat(cocktail.Servings, 'f', -1, 64))
+	}
+
+	fmt.Fprintf(&sb, "\nRecipes from [Cezzis.com](%s)\n", config.GetAppSettings().CezzisBaseURL)
+
+	return sb.String()
+}
+
+// ShoppingListCSV renders the shopping list as CSV with one row per ingredient, those to buy first.  Ingredients
+// already stocked in the bar have the name of the stocked ingredient in the in_bar column, and ingredients to buy
+// that might be replaced by a stocked sibling have its name in the substitute column.
+func ShoppingListCSV(list *shopping.List) (string, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	rows := [][]string{shoppingListCSVHeader}
+	for _, group := range list.ToBuy {
+		for _, item := range group.Items {
+			rows = append(rows, shoppingListCSVRow(item))
+		}
+	}
+	for _, item := range list.InBar {
+		rows = append(rows, shoppingListCSVRow(item))
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// shoppingListCSVRow converts the item into a CSV row, leaving the amount and count blank when there are none.
+func shoppingListCSVRow(item shopping.Item) []string {
+	amount, count := "", ""
+	if item.Amount > 0 {
+		amount = strconv.FormatFloat(item.Amount, 'f', -1, 64)
+	}
+	if item.Count > 0 {
+		count = strconv.FormatFloat(item.Count, 'f', -1, 64)
+	}
+
+	return []string{
+		item.Category,
+		item.Name,
+		amount,
+		item.Unit,
+		count,
+		item.Display,
+		strings.Join(item.Cocktails, "; "),
+		item.InBar,
+		item.Substitute,
+	}
+}
@@ -0,0 +1,82 @@
+package rendering_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/rendering"
+	"cezzis.com/cezzis-mcp-server/internal/shopping"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+)
+
+func partyShoppingList() *shopping.List {
+	return &shopping.List{
+		Unit: "oz",
+		Cocktails: []shopping.Cocktail{
+			{ID: "daiquiri", Title: "Daiquiri", Servings: 4},
+			{ID: "gimlet", Title: "Gimlet", Servings: 1.5},
+		},
+		ToBuy: []shopping.Group{
+			{Category: "Spirits", Items: []shopping.Item{
+				{Name: "White Rum", Category: "Spirits", Amount: 8, Unit: "oz", Display: "8 oz White Rum", Cocktails: []string{"Daiquiri"}, Substitute: "Aged Rum"},
+			}},
+			{Category: "Fruits & Citrus", Items: []shopping.Item{
+				{Name: "Lime Juice", Category: "Fruits & Citrus", Amount: 6.25, Unit: "oz", Display: "6 1/4 oz Lime Juice", Cocktails: []string{"Daiquiri", "Gimlet"}},
+				{Name: "Lime Wheel", Category: "Fruits & Citrus", Count: 2, Display: "2 Lime Wheel", Cocktails: []string{"Gimlet"}},
+			}},
+		},
+		InBar: []shopping.Item{
+			{Name: "Gin", Category: "Spirits", Amount: 3, Unit: "oz", Display: "3 oz Gin", Cocktails: []string{"Gimlet"}, InBar: "London Dry Gin"},
+			{Name: "Simple Syrup", Category: "Sweeteners & Syrups", Amount: 3, Unit: "oz", Display: "3 oz Simple Syrup", Cocktails: []string{"Daiquiri"}, InBar: "Simple Syrup"},
+		},
+	}
+}
+
+func Test_shopping_list_markdown_renders_categories_bar_and_cocktails(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+
+	// act
+	markdown := rendering.ShoppingListMarkdown(partyShoppingList(), "Party Shopping List")
+
+	// assert
+	require.Contains(t, markdown, "# Party Shopping List\n")
+	require.Contains(t, markdown, "## Spirits\n\n- [ ] 8 oz White Rum _(Daiquiri)_, or use Aged Rum from your bar\n")
+	require.Contains(t, markdown, "## Fruits & Citrus\n\n- [ ] 6 1/4 oz Lime Juice _(Daiquiri, Gimlet)_\n- [ ] 2 Lime Wheel _(Gimlet)_\n")
+	require.Contains(t, markdown, "## Already in your bar\n\n- [x] 3 oz Gin, using London Dry Gin\n- [x] 3 oz Simple Syrup\n")
+	require.Contains(t, markdown, "- [Gimlet](http://localhost:4003/cocktails/gimlet) x 1.5\n")
+}
+
+func Test_shopping_list_markdown_notes_when_nothing_to_buy(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+
+	list := partyShoppingList()
+	list.ToBuy = nil
+
+	// act
+	markdown := rendering.ShoppingListMarkdown(list, "Shopping List")
+
+	// assert
+	require.Contains(t, markdown, "Nothing to buy, everything is already in your bar.")
+}
+
+func Test_shopping_list_csv_renders_a_row_per_ingredient(t *testing.T) {
+	// arrange
+	t.Parallel()
+
+	// act
+	csv, err := rendering.ShoppingListCSV(partyShoppingList())
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, "category,ingredient,amount,unit,count,display,cocktails,in_bar,substitute\n"+
+		"Spirits,White Rum,8,oz,,8 oz White Rum,Daiquiri,,Aged Rum\n"+
+		"Fruits & Citrus,Lime Juice,6.25,oz,,6 1/4 oz Lime Juice,Daiquiri; Gimlet,,\n"+
+		"Fruits & Citrus,Lime Wheel,,,2,2 Lime Wheel,Gimlet,,\n"+
+		"Spirits,Gin,3,oz,,3 oz Gin,Gimlet,London Dry Gin,\n"+
+		"Sweeteners & Syrups,Simple Syrup,3,oz,,3 oz Simple Syrup,Daiquiri,Simple Syrup,\n", csv)
+}
@@ -0,0 +1,6 @@
+package repos
+
+// NewBarInventoryRepositoryWithPool creates a bar inventory repository that runs its SQL through the supplied pool.
+func NewBarInventoryRepositoryWithPool(pool barInventoryPool) *PostgresBarInventoryRepository {
+	return &PostgresBarInventoryRepository{pool: pool}
+}
@@ -0,0 +1,190 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+// BarIngredient represents an ingredient stocked in one of a user's bars
+type BarIngredient struct {
+	IngredientID string    `json:"ingredientId"`
+	Name         string    `json:"name"`
+	ParentID     string    `json:"parentId,omitempty"`
+	AddedOn      time.Time `json:"addedOn"`
+}
+
+// BarInventoryRepository manages the ingredients stocked in users' bars
+type BarInventoryRepository interface {
+	AddIngredients(ctx context.Context, userID string, barID string, ingredients []BarIngredient) error
+	RemoveIngredients(ctx context.Context, userID string, barID string, ingredientIDs []string) (int64, error)
+	RemoveBar(ctx context.Context, userID string, barID string) (int64, error)
+	ListIngredients(ctx context.Context, userID string, barID string) ([]BarIngredient, error)
+}
+
+// barInventoryPool is the subset of a PostgreSQL connection pool used by the bar inventory repository
+type barInventoryPool interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults
+}
+
+// PostgresBarInventoryRepository manages the ingredients stocked in users' bars in PostgreSQL
+type PostgresBarInventoryRepository struct {
+	pool barInventoryPool
+}
+
+// NewPostgresBarInventoryRepository creates a new PostgreSQL bar inventory repository instance
+func NewPostgresBarInventoryRepository(pool *pgxpool.Pool) *PostgresBarInventoryRepository {
+	return &PostgresBarInventoryRepository{pool: pool}
+}
+
+// AddIngredients upserts ingredients into a user's bar, keeping the original date each ingredient was added
+func (r *PostgresBarInventoryRepository) AddIngredients(ctx context.Context, userID string, barID string, ingredients []BarIngredient) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	ctx, span := telemetry.Tracer.Start(ctx, "PostgreSQL.UpsertItems")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "upsert_items"),
+		attribute.String("db.sql.table", "bar_ingredients"),
+		attribute.String("db.item_id", barID),
+		attribute.Int("db.item_count", len(ingredients)),
+	)
+
+	batch := &pgx.Batch{}
+	for _, ingredient := range ingredients {
+		batch.Queue(`
+			INSERT INTO bar_ingredients (user_id, bar_id, ingredient_id, ingredient_name, parent_id)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (user_id, bar_id, ingredient_id) DO UPDATE SET
+				ingredient_name = EXCLUDED.ingredient_name,
+				parent_id = EXCLUDED.parent_id
+		`, userID, barID, ingredient.IngredientID, ingredient.Name, ingredient.ParentID)
+	}
+
+	if err := r.pool.SendBatch(ctx, batch).Close(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "PostgreSQL upsert failed")
+		return fmt.Errorf("failed to add bar ingredients: %w", err)
+	}
+
+	span.SetStatus(codes.Ok, "PostgreSQL upsert succeeded")
+	return nil
+}
+
+// RemoveIngredients removes ingredients from a user's bar and returns the number that were removed
+func (r *PostgresBarInventoryRepository) RemoveIngredients(ctx context.Context, userID string, barID string, ingredientIDs []string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	ctx, span := telemetry.Tracer.Start(ctx, "PostgreSQL.DeleteItems")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "delete_items"),
+		attribute.String("db.sql.table", "bar_ingredients"),
+		attribute.String("db.item_id", barID),
+		attribute.Int("db.item_count", len(ingredientIDs)),
+	)
+
+	result, err := r.pool.Exec(ctx, `
+		DELETE FROM bar_ingredients
+		WHERE user_id = $1 AND bar_id = $2 AND ingredient_id = ANY($3)
+	`, userID, barID, ingredientIDs)
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "PostgreSQL delete failed")
+		return 0, fmt.Errorf("failed to remove bar ingredients: %w", err)
+	}
+
+	span.SetStatus(codes.Ok, "PostgreSQL delete succeeded")
+	return result.RowsAffected(), nil
+}
+
+// RemoveBar removes every ingredient stocked in a user's bar, such as when the bar is deleted, and returns the
+// number that were removed
+func (r *PostgresBarInventoryRepository) RemoveBar(ctx context.Context, userID string, barID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	ctx, span := telemetry.Tracer.Start(ctx, "PostgreSQL.DeleteItems")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "delete_items"),
+		attribute.String("db.sql.table", "bar_ingredients"),
+		attribute.String("db.item_id", barID),
+	)
+
+	result, err := r.pool.Exec(ctx, `
+		DELETE FROM bar_ingredients
+		WHERE user_id = $1 AND bar_id = $2
+	`, userID, barID)
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "PostgreSQL delete failed")
+		return 0, fmt.Errorf("failed to remove bar ingredients: %w", err)
+	}
+
+	span.SetStatus(codes.Ok, "PostgreSQL delete succeeded")
+	return result.RowsAffected(), nil
+}
+
+// ListIngredients retrieves the ingredients in a user's bar ordered by name
+func (r *PostgresBarInventoryRepository) ListIngredients(ctx context.Context, userID string, barID string) ([]BarIngredient, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	ctx, span := telemetry.Tracer.Start(ctx, "PostgreSQL.ReadItems")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.operation", "read_items"),
+		attribute.String("db.sql.table", "bar_ingredients"),
+		attribute.String("db.item_id", barID),
+	)
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT ingredient_id, ingredient_name, parent_id, added_on
+		FROM bar_ingredients WHERE user_id = $1 AND bar_id = $2
+		ORDER BY ingredient_name, ingredient_id
+	`, userID, barID)
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "PostgreSQL read failed")
+		return nil, fmt.Errorf("failed to list bar ingredients: %w", err)
+	}
+
+	ingredients, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (BarIngredient, error) {
+		var ingredient BarIngredient
+		err := row.Scan(&ingredient.IngredientID, &ingredient.Name, &ingredient.ParentID, &ingredient.AddedOn)
+		return ingredient, err
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "PostgreSQL read failed")
+		return nil, fmt.Errorf("failed to list bar ingredients: %w", err)
+	}
+
+	span.SetStatus(codes.Ok, "PostgreSQL read succeeded")
+	return ingredients, nil
+}
@@ -0,0 +1,138 @@
+package repos_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"cezzis.com/cezzis-mcp-server/internal/repos"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+// fakePool records the SQL sent to it in place of a PostgreSQL connection pool.
+type fakePool struct {
+	batch     *pgx.Batch
+	sql       string
+	arguments []any
+	tag       string
+	err       error
+}
+
+func (pool *fakePool) Exec(_ context.Context, sql string, arguments ...any) (pgconn.CommandTag, error) {
+	pool.sql = sql
+	pool.arguments = arguments
+	return pgconn.NewCommandTag(pool.tag), pool.err
+}
+
+func (pool *fakePool) Query(_ context.Context, sql string, arguments ...any) (pgx.Rows, error) {
+	pool.sql = sql
+	pool.arguments = arguments
+	return nil, pool.err
+}
+
+func (pool *fakePool) SendBatch(_ context.Context, batch *pgx.Batch) pgx.BatchResults {
+	pool.batch = batch
+	return fakeBatchResults{err: pool.err}
+}
+
+// fakeBatchResults only supports closing the batch, which is all the repository does with it.
+type fakeBatchResults struct {
+	pgx.BatchResults
+	err error
+}
+
+func (results fakeBatchResults) Close() error {
+	return results.err
+}
+
+// collapse removes the indentation and line breaks from the SQL.
+func collapse(sql string) string {
+	return strings.Join(strings.Fields(sql), " ")
+}
+
+func init() {
+	if telemetry.Tracer == nil {
+		telemetry.Tracer = otel.Tracer("repos_test")
+	}
+}
+
+func Test_add_ingredients_upserts_each_ingredient_keeping_when_it_was_added(t *testing.T) {
+	// arrange
+	pool := &fakePool{}
+	repository := repos.NewBarInventoryRepositoryWithPool(pool)
+
+	// act
+	err := repository.AddIngredients(context.Background(), "user-1", "bar-1", []repos.BarIngredient{
+		{IngredientID: "london-dry-gin", Name: "London Dry Gin", ParentID: "gin"},
+		{IngredientID: "campari", Name: "Campari"},
+	})
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, pool.batch.QueuedQueries, 2)
+
+	query := pool.batch.QueuedQueries[0]
+	require.Equal(t, "INSERT INTO bar_ingredients (user_id, bar_id, ingredient_id, ingredient_name, parent_id) VALUES ($1, $2, $3, $4, $5) "+
+		"ON CONFLICT (user_id, bar_id, ingredient_id) DO UPDATE SET ingredient_name = EXCLUDED.ingredient_name, parent_id = EXCLUDED.parent_id",
+		collapse(query.SQL))
+	require.NotContains(t, query.SQL, "added_on")
+	require.Equal(t, []any{"user-1", "bar-1", "london-dry-gin", "London Dry Gin", "gin"}, query.Arguments)
+	require.Equal(t, []any{"user-1", "bar-1", "campari", "Campari", ""}, pool.batch.QueuedQueries[1].Arguments)
+}
+
+func Test_add_ingredients_wraps_batch_errors(t *testing.T) {
+	pool := &fakePool{err: errors.New("connection refused")}
+	repository := repos.NewBarInventoryRepositoryWithPool(pool)
+
+	err := repository.AddIngredients(context.Background(), "user-1", "bar-1", []repos.BarIngredient{{IngredientID: "gin", Name: "Gin"}})
+
+	require.EqualError(t, err, "failed to add bar ingredients: connection refused")
+}
+
+func Test_remove_ingredients_deletes_the_ingredients_from_the_users_bar(t *testing.T) {
+	// arrange
+	pool := &fakePool{tag: "DELETE 2"}
+	repository := repos.NewBarInventoryRepositoryWithPool(pool)
+
+	// act
+	removed, err := repository.RemoveIngredients(context.Background(), "user-1", "bar-1", []string{"gin", "campari"})
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, int64(2), removed)
+	require.Equal(t, "DELETE FROM bar_ingredients WHERE user_id = $1 AND bar_id = $2 AND ingredient_id = ANY($3)", collapse(pool.sql))
+	require.Equal(t, []any{"user-1", "bar-1", []string{"gin", "campari"}}, pool.arguments)
+}
+
+func Test_remove_bar_deletes_every_ingredient_in_the_users_bar(t *testing.T) {
+	// arrange
+	pool := &fakePool{tag: "DELETE 5"}
+	repository := repos.NewBarInventoryRepositoryWithPool(pool)
+
+	// act
+	removed, err := repository.RemoveBar(context.Background(), "user-1", "bar-1")
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, int64(5), removed)
+	require.Equal(t, "DELETE FROM bar_ingredients WHERE user_id = $1 AND bar_id = $2", collapse(pool.sql))
+	require.Equal(t, []any{"user-1", "bar-1"}, pool.arguments)
+}
+
+func Test_list_ingredients_scopes_the_query_to_the_users_bar(t *testing.T) {
+	pool := &fakePool{err: errors.New("connection refused")}
+	repository := repos.NewBarInventoryRepositoryWithPool(pool)
+
+	_, err := repository.ListIngredients(context.Background(), "user-1", "bar-1")
+
+	require.EqualError(t, err, "failed to list bar ingredients: connection refused")
+	require.Equal(t, "SELECT ingredient_id, ingredient_name, parent_id, added_on FROM bar_ingredients WHERE user_id = $1 AND bar_id = $2 "+
+		"ORDER BY ingredient_name, ingredient_id", collapse(pool.sql))
+	require.Equal(t, []any{"user-1", "bar-1"}, pool.arguments)
+}
@@ -1,5 +1,5 @@
 // Package repos provides data repository implementations
-// for managing session tokens in PostgreSQL.
+// for managing session tokens and bar inventories in PostgreSQL.
 package repos
 
 import (
@@ -0,0 +1,242 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/middleware"
+	"cezzis.com/cezzis-mcp-server/internal/rendering"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+const (
+	// RecentCocktailsResourceURI is the URI of the resource listing the cocktails recently read in the current session.
+	RecentCocktailsResourceURI = "cezzis://cocktails/recent"
+	// CocktailResourceURITemplate is the URI template of the JSON rendition of a single cocktail recipe.
+	CocktailResourceURITemplate = "cezzis://cocktails/{id}"
+	// CocktailMarkdownResourceURITemplate is the URI template of the Markdown rendition of a single cocktail recipe.
+	CocktailMarkdownResourceURITemplate = "cezzis://cocktails/{id}/markdown"
+
+	// maxRecentCocktails is the number of recently read cocktails remembered for each session.
+	maxRecentCocktails = 20
+	// maxRecentSessions bounds the number of sessions whose recently read cocktails are remembered.
+	maxRecentSessions = 1000
+)
+
+// RecentCocktailsResource is an MCP resource listing the cocktails recently read through the cocktail resource templates.
+var RecentCocktailsResource = mcp.NewResource(
+	RecentCocktailsResourceURI,
+	"Recently accessed Cezzis.com cocktails",
+	mcp.WithResourceDescription("The cocktails most recently read through the cezzis://cocktails/{id} resources in this session, newest first, with the URIs of their JSON and Markdown renditions."),
+	mcp.WithMIMEType("application/json"),
+)
+
+// CocktailResourceTemplate is an MCP resource template exposing the JSON rendition of each cocktail recipe by its id.
+var CocktailResourceTemplate = mcp.NewResourceTemplate(
+	CocktailResourceURITemplate,
+	"Cezzis.com cocktail recipe",
+	mcp.WithTemplateDescription("A single Cezzis.com cocktail recipe as JSON including ingredients, instructions, glassware, ratings and the full descriptive content."),
+	mcp.WithTemplateMIMEType("application/json"),
+)
+
+// CocktailMarkdownResourceTemplate is an MCP resource template exposing the Markdown rendition of each cocktail recipe by its id.
+var CocktailMarkdownResourceTemplate = mcp.NewResourceTemplate(
+	CocktailMarkdownResourceURITemplate,
+	"Cezzis.com cocktail recipe (Markdown)",
+	mcp.WithTemplateDescription("A single Cezzis.com cocktail recipe rendered as Markdown, suitable for pinning as readable context."),
+	mcp.WithTemplateMIMEType("text/markdown"),
+)
+
+// RecentCocktail describes a cocktail recently read through the cocktail resource templates.
+type RecentCocktail struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	URI         string    `json:"uri"`
+	MarkdownURI string    `json:"markdownUri"`
+	AccessedAt  time.Time `json:"accessedAt"`
+}
+
+// CocktailResourceHandler handles reads of the cocktail resources and remembers the cocktails read in each session.
+type CocktailResourceHandler struct {
+	client *cocktailsapi.Client
+
+	mu       sync.Mutex
+	recent   map[string][]RecentCocktail
+	lastSeen map[string]time.Time
+}
+
+// NewCocktailResourceHandler creates a new instance of CocktailResourceHandler with the provided API client.
+func NewCocktailResourceHandler(client *cocktailsapi.Client) *CocktailResourceHandler {
+	return &CocktailResourceHandler{
+		client:   client,
+		recent:   make(map[string][]RecentCocktail),
+		lastSeen: make(map[string]time.Time),
+	}
+}
+
+// HandleRecent reads the cezzis://cocktails/recent resource returning the cocktails recently read in the current session.
+func (handler *CocktailResourceHandler) HandleRecent(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP Reading recent cocktails resource")
+
+	body, err := json.Marshal(handler.recentCocktails(sessionID(ctx)))
+	if err != nil {
+		return nil, err
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      request.Params.URI,
+			MIMEType: "application/json",
+			Text:     string(body),
+		},
+	}, nil
+}
+
+// HandleJSON reads a cezzis://cocktails/{id} resource returning the cocktail recipe as JSON.
+func (handler *CocktailResourceHandler) HandleJSON(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	cocktail, err := handler.read(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(cocktail)
+	if err != nil {
+		return nil, err
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      request.Params.URI,
+			MIMEType: "application/json",
+			Text:     string(body),
+		},
+	}, nil
+}
+
+// HandleMarkdown reads a cezzis://cocktails/{id}/markdown resource returning the cocktail recipe rendered as Markdown.
+func (handler *CocktailResourceHandler) HandleMarkdown(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	cocktail, err := handler.read(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      request.Params.URI,
+			MIMEType: "text/markdown",
+			Text:     rendering.FullRecipe(cocktail),
+		},
+	}, nil
+}
+
+// read retrieves the cocktail named by the request's id and records it as recently accessed for the session.
+func (handler *CocktailResourceHandler) read(ctx context.Context, request mcp.ReadResourceRequest) (*cocktailsapi.CocktailModel, error) {
+	cocktailID, err := resourceArgument(request, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP Reading cocktail resource: " + cocktailID)
+
+	operation := "reading cocktail " + cocktailID
+	body, err := readResource(ctx, operation, func(callCtx context.Context) (*http.Response, error) {
+		resolveIngredients := true
+
+		return handler.client.GetCocktail(callCtx, cocktailID, &cocktailsapi.GetCocktailParams{
+			ResolveIngredients: &resolveIngredients,
+			XKey:               &config.GetAppSettings().CocktailsAPISubscriptionKey,
+		}, cocktailsapi.RequestEditor())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var cocktailRs cocktailsapi.CocktailRs
+	if err := json.Unmarshal(body, &cocktailRs); err != nil {
+		telemetry.Logger.Err(err).Ctx(ctx).Msg("MCP Error decoding rs when " + operation)
+		return nil, fmt.Errorf("%s: invalid response: %w", operation, err)
+	}
+
+	handler.remember(sessionID(ctx), cocktailRs.Item)
+
+	return &cocktailRs.Item, nil
+}
+
+// remember records the cocktail as the most recently accessed one for the session, keeping the list bounded.  Reads
+// without a session are not remembered, so sessionless clients never share a list.
+func (handler *CocktailResourceHandler) remember(session string, cocktail cocktailsapi.CocktailModel) {
+	if session == "" {
+		return
+	}
+
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
+	now := time.Now().UTC()
+
+	if _, ok := handler.recent[session]; !ok && len(handler.recent) >= maxRecentSessions {
+		handler.evictOldestSession()
+	}
+
+	entries := []RecentCocktail{{
+		ID:          cocktail.Id,
+		Title:       cocktail.Title,
+		URI:         "cezzis://cocktails/" + cocktail.Id,
+		MarkdownURI: "cezzis://cocktails/" + cocktail.Id + "/markdown",
+		AccessedAt:  now,
+	}}
+
+	for _, entry := range handler.recent[session] {
+		if entry.ID != cocktail.Id && len(entries) < maxRecentCocktails {
+			entries = append(entries, entry)
+		}
+	}
+
+	handler.recent[session] = entries
+	handler.lastSeen[session] = now
+}
+
+// evictOldestSession forgets the session that least recently read a cocktail.  The caller must hold the lock.
+func (handler *CocktailResourceHandler) evictOldestSession() {
+	var oldest string
+	var oldestSeen time.Time
+
+	for session, seen := range handler.lastSeen {
+		if oldestSeen.IsZero() || seen.Before(oldestSeen) {
+			oldest, oldestSeen = session, seen
+		}
+	}
+
+	delete(handler.recent, oldest)
+	delete(handler.lastSeen, oldest)
+}
+
+// recentCocktails returns a copy of the cocktails recently accessed in the session, newest first.  Without a
+// session the list is empty.
+func (handler *CocktailResourceHandler) recentCocktails(session string) []RecentCocktail {
+	if session == "" {
+		return []RecentCocktail{}
+	}
+
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+
+	entries := make([]RecentCocktail, len(handler.recent[session]))
+	copy(entries, handler.recent[session])
+
+	return entries
+}
+
+// sessionID returns the MCP session identifier placed on the context by the request middleware, if any.
+func sessionID(ctx context.Context) string {
+	session, _ := ctx.Value(middleware.McpSessionIDKey).(string)
+	return session
+}
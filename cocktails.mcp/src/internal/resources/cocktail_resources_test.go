@@ -0,0 +1,143 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/middleware"
+	"cezzis.com/cezzis-mcp-server/internal/resources"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+)
+
+func cocktailReadRequest(uri, id string) mcp.ReadResourceRequest {
+	return mcp.ReadResourceRequest{
+		Params: mcp.ReadResourceParams{
+			URI:       uri,
+			Arguments: map[string]any{"id": []string{id}},
+		},
+	}
+}
+
+func Test_cocktail_resource_template_renders_json_and_markdown(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, _ := testutils.Setup(t)
+
+	mux.HandleFunc("/api/v1/cocktails/mojito", func(w http.ResponseWriter, r *http.Request) {
+		testutils.TestMethod(t, r, "GET")
+		require.Equal(t, "true", r.URL.Query().Get("resolveIngredients"))
+		fmt.Fprint(w, `{"item":{"id":"mojito","title":"Mojito","description":"A Cuban highball.","serves":1,"ingredients":[{"name":"White Rum","display":"2 oz White Rum"}],"content":"Muddle the mint."}}`)
+	})
+
+	handler := resources.NewCocktailResourceHandler(client)
+
+	// act
+	jsonContents, jsonErr := handler.HandleJSON(ctx, cocktailReadRequest("cezzis://cocktails/mojito", "mojito"))
+	markdownContents, markdownErr := handler.HandleMarkdown(ctx, cocktailReadRequest("cezzis://cocktails/mojito/markdown", "mojito"))
+
+	// assert
+	require.NoError(t, jsonErr)
+	require.Len(t, jsonContents, 1)
+
+	jsonText, ok := jsonContents[0].(mcp.TextResourceContents)
+	require.True(t, ok, "Contents should be of type TextResourceContents")
+	require.Equal(t, "application/json", jsonText.MIMEType)
+	require.Contains(t, jsonText.Text, `"id":"mojito"`)
+	require.Contains(t, jsonText.Text, `"title":"Mojito"`)
+
+	require.NoError(t, markdownErr)
+	require.Len(t, markdownContents, 1)
+
+	markdownText, ok := markdownContents[0].(mcp.TextResourceContents)
+	require.True(t, ok, "Contents should be of type TextResourceContents")
+	require.Equal(t, "cezzis://cocktails/mojito/markdown", markdownText.URI)
+	require.Equal(t, "text/markdown", markdownText.MIMEType)
+	require.Contains(t, markdownText.Text, "# Mojito")
+	require.Contains(t, markdownText.Text, "- 2 oz White Rum")
+	require.Contains(t, markdownText.Text, "Muddle the mint.")
+}
+
+func Test_recent_cocktails_resource_lists_reads_newest_first(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, _ := testutils.Setup(t)
+
+	for _, id := range []string{"mojito", "negroni"} {
+		mux.HandleFunc("/api/v1/cocktails/"+id, func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"item":{"id":"%s","title":"%s"}}`, id, id)
+		})
+	}
+
+	handler := resources.NewCocktailResourceHandler(client)
+
+	for _, id := range []string{"mojito", "negroni", "mojito"} {
+		_, err := handler.HandleJSON(ctx, cocktailReadRequest("cezzis://cocktails/"+id, id))
+		require.NoError(t, err)
+	}
+
+	// act
+	contents, err := handler.HandleRecent(ctx, mcp.ReadResourceRequest{
+		Params: mcp.ReadResourceParams{URI: resources.RecentCocktailsResourceURI},
+	})
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, contents, 1)
+
+	text, ok := contents[0].(mcp.TextResourceContents)
+	require.True(t, ok, "Contents should be of type TextResourceContents")
+
+	var recent []resources.RecentCocktail
+	require.NoError(t, json.Unmarshal([]byte(text.Text), &recent))
+	require.Len(t, recent, 2)
+	require.Equal(t, "mojito", recent[0].ID)
+	require.Equal(t, "cezzis://cocktails/mojito/markdown", recent[0].MarkdownURI)
+	require.Equal(t, "negroni", recent[1].ID)
+}
+
+func Test_recent_cocktails_resource_is_empty_for_new_session(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, _, ctx, _ := testutils.Setup(t)
+
+	handler := resources.NewCocktailResourceHandler(client)
+
+	// act
+	contents, err := handler.HandleRecent(ctx, mcp.ReadResourceRequest{
+		Params: mcp.ReadResourceParams{URI: resources.RecentCocktailsResourceURI},
+	})
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, "[]", contents[0].(mcp.TextResourceContents).Text)
+}
+
+func Test_recent_cocktails_resource_does_not_remember_reads_without_a_session(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, _, ctx, _ := testutils.Setup(t)
+
+	sessionless := context.WithValue(ctx, middleware.McpSessionIDKey, "")
+	handler := resources.NewCocktailResourceHandler(client)
+	handler.Remember("", cocktailsapi.CocktailModel{Id: "mojito", Title: "Mojito"})
+
+	// act
+	contents, err := handler.HandleRecent(sessionless, mcp.ReadResourceRequest{
+		Params: mcp.ReadResourceParams{URI: resources.RecentCocktailsResourceURI},
+	})
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, "[]", contents[0].(mcp.TextResourceContents).Text)
+}
@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+const (
+	// CollectionsResourceURI is the URI of the resource listing all curated cocktail collections.
+	CollectionsResourceURI = "cezzis://collections"
+
+	// CollectionResourceURITemplate is the URI template of the resource for a single curated cocktail collection.
+	CollectionResourceURITemplate = "cezzis://collections/{id}"
+)
+
+// CollectionsResource is an MCP resource listing the curated cocktail collections from the Cezzis.com cocktails API.
+var CollectionsResource = mcp.NewResource(
+	CollectionsResourceURI,
+	"Cezzis.com cocktail collections",
+	mcp.WithResourceDescription("The curated Cezzis.com cocktail collections such as seasonal, holiday and themed collections.  Each collection can be read using the cezzis://collections/{id} resource."),
+	mcp.WithMIMEType("application/json"),
+)
+
+// CollectionResourceTemplate is an MCP resource template exposing each curated cocktail collection by its id.  The
+// collections are curated upstream and change over time, so they are not registered as individual resources; their
+// ids are read from CollectionsResource.
+var CollectionResourceTemplate = mcp.NewResourceTemplate(
+	CollectionResourceURITemplate,
+	"Cezzis.com cocktail collection",
+	mcp.WithTemplateDescription("A single curated Cezzis.com cocktail collection including the ordered ids of its cocktails."),
+	mcp.WithTemplateMIMEType("application/json"),
+)
+
+// CollectionResourceHandler handles reads of the cocktail collection resources.
+type CollectionResourceHandler struct {
+	client *cocktailsapi.Client
+}
+
+// NewCollectionResourceHandler creates a new instance of CollectionResourceHandler with the provided API client.
+func NewCollectionResourceHandler(client *cocktailsapi.Client) *CollectionResourceHandler {
+	return &CollectionResourceHandler{
+		client: client,
+	}
+}
+
+// HandleList reads the cezzis://collections resource returning all curated cocktail collections.
+func (handler CollectionResourceHandler) HandleList(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP Reading cocktail collections resource")
+
+	body, err := readResource(ctx, "reading cocktail collections", func(callCtx context.Context) (*http.Response, error) {
+		return handler.client.GetCocktailCollections(callCtx, &cocktailsapi.GetCocktailCollectionsParams{
+			XKey: &config.GetAppSettings().CocktailsAPISubscriptionKey,
+		}, cocktailsapi.RequestEditor())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      request.Params.URI,
+			MIMEType: "application/json",
+			Text:     string(body),
+		},
+	}, nil
+}
+
+// HandleTemplate reads a cezzis://collections/{id} resource returning a single curated cocktail collection.
+func (handler CollectionResourceHandler) HandleTemplate(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	collectionID, err := resourceArgument(request, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP Reading cocktail collection resource: " + collectionID)
+
+	body, err := readResource(ctx, "reading cocktail collection "+collectionID, func(callCtx context.Context) (*http.Response, error) {
+		return handler.client.GetCocktailCollection(callCtx, collectionID, &cocktailsapi.GetCocktailCollectionParams{
+			XKey: &config.GetAppSettings().CocktailsAPISubscriptionKey,
+		}, cocktailsapi.RequestEditor())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      request.Params.URI,
+			MIMEType: "application/json",
+			Text:     string(body),
+		},
+	}, nil
+}
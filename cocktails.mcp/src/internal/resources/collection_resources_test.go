@@ -0,0 +1,97 @@
+package resources_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/resources"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+)
+
+func Test_collection_resource_template_reads_collection_by_id(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, _ := testutils.Setup(t)
+
+	body := `{"item":{"id":"holiday","title":"Holiday Cocktails","cocktailIds":["eggnog"]}}`
+
+	mux.HandleFunc("/api/v1/cocktails/collections/holiday", func(w http.ResponseWriter, r *http.Request) {
+		testutils.TestMethod(t, r, "GET")
+		fmt.Fprint(w, body)
+	})
+
+	request := mcp.ReadResourceRequest{
+		Params: mcp.ReadResourceParams{
+			URI:       "cezzis://collections/holiday",
+			Arguments: map[string]any{"id": []string{"holiday"}},
+		},
+	}
+
+	handler := resources.NewCollectionResourceHandler(client)
+
+	// act
+	contents, err := handler.HandleTemplate(ctx, request)
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, contents, 1)
+
+	text, ok := contents[0].(mcp.TextResourceContents)
+	require.True(t, ok, "Contents should be of type TextResourceContents")
+	require.Equal(t, "cezzis://collections/holiday", text.URI)
+	require.Equal(t, "application/json", text.MIMEType)
+	require.Equal(t, body, text.Text)
+}
+
+func Test_collection_resource_template_returns_error_on_missing_id(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, _, ctx, _ := testutils.Setup(t)
+
+	request := mcp.ReadResourceRequest{
+		Params: mcp.ReadResourceParams{
+			URI: "cezzis://collections/",
+		},
+	}
+
+	handler := resources.NewCollectionResourceHandler(client)
+
+	// act
+	contents, err := handler.HandleTemplate(ctx, request)
+
+	// assert
+	require.Nil(t, contents)
+	require.ErrorContains(t, err, "is missing the \"id\" value")
+}
+
+func Test_collections_resource_returns_error_on_upstream_failure(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, _ := testutils.Setup(t)
+
+	mux.HandleFunc("/api/v1/cocktails/collections", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "boom", http.StatusInternalServerError)
+	})
+
+	request := mcp.ReadResourceRequest{
+		Params: mcp.ReadResourceParams{
+			URI: resources.CollectionsResourceURI,
+		},
+	}
+
+	handler := resources.NewCollectionResourceHandler(client)
+
+	// act
+	contents, err := handler.HandleList(ctx, request)
+
+	// assert
+	require.Nil(t, contents)
+	require.ErrorContains(t, err, "reading cocktail collections failed (status 500): boom")
+}
@@ -0,0 +1,8 @@
+package resources
+
+import "cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+
+// Remember records the cocktail as read in the session, as a successful cocktail resource read does.
+func (handler *CocktailResourceHandler) Remember(session string, cocktail cocktailsapi.CocktailModel) {
+	handler.remember(session, cocktail)
+}
@@ -0,0 +1,111 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+const (
+	// PrivacyPolicyResourceURI is the URI of the resource exposing the Cezzis.com privacy policy.
+	PrivacyPolicyResourceURI = "cezzis://legal/privacy-policy"
+	// TermsOfServiceResourceURI is the URI of the resource exposing the Cezzis.com terms of service.
+	TermsOfServiceResourceURI = "cezzis://legal/terms-of-service"
+)
+
+// PrivacyPolicyResource is an MCP resource exposing the Cezzis.com privacy policy.  No MIME type is declared because
+// it depends on the format of the document, which is only known once it is read.
+var PrivacyPolicyResource = mcp.NewResource(
+	PrivacyPolicyResourceURI,
+	"Cezzis.com privacy policy",
+	mcp.WithResourceDescription("The Cezzis.com privacy policy describing how account and usage data is collected and used."),
+)
+
+// TermsOfServiceResource is an MCP resource exposing the Cezzis.com terms of service.  As with the privacy policy, its
+// MIME type is only known once the document is read.
+var TermsOfServiceResource = mcp.NewResource(
+	TermsOfServiceResourceURI,
+	"Cezzis.com terms of service",
+	mcp.WithResourceDescription("The Cezzis.com terms of service governing use of the site, the cocktails API and this MCP server."),
+)
+
+// LegalResourceHandler handles reads of the legal document resources.
+type LegalResourceHandler struct {
+	client *cocktailsapi.Client
+}
+
+// NewLegalResourceHandler creates a new instance of LegalResourceHandler with the provided API client.
+func NewLegalResourceHandler(client *cocktailsapi.Client) *LegalResourceHandler {
+	return &LegalResourceHandler{
+		client: client,
+	}
+}
+
+// HandlePrivacyPolicy reads the cezzis://legal/privacy-policy resource.
+func (handler LegalResourceHandler) HandlePrivacyPolicy(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP Reading privacy policy resource")
+
+	return handler.read(ctx, request, "reading privacy policy", func(callCtx context.Context) (*http.Response, error) {
+		return handler.client.GetPrivacyPolicy(callCtx, &cocktailsapi.GetPrivacyPolicyParams{
+			XKey: &config.GetAppSettings().CocktailsAPISubscriptionKey,
+		}, cocktailsapi.RequestEditor())
+	})
+}
+
+// HandleTermsOfService reads the cezzis://legal/terms-of-service resource.
+func (handler LegalResourceHandler) HandleTermsOfService(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP Reading terms of service resource")
+
+	return handler.read(ctx, request, "reading terms of service", func(callCtx context.Context) (*http.Response, error) {
+		return handler.client.GetTermsOfService(callCtx, &cocktailsapi.GetTermsOfServiceParams{
+			XKey: &config.GetAppSettings().CocktailsAPISubscriptionKey,
+		}, cocktailsapi.RequestEditor())
+	})
+}
+
+// read calls the upstream legal document endpoint and returns the document as text contents typed by its format.
+func (handler LegalResourceHandler) read(ctx context.Context, request mcp.ReadResourceRequest, operation string, call func(ctx context.Context) (*http.Response, error)) ([]mcp.ResourceContents, error) {
+	body, err := readResource(ctx, operation, call)
+	if err != nil {
+		return nil, err
+	}
+
+	var document cocktailsapi.LegalDocumentRs
+	if err := json.Unmarshal(body, &document); err != nil {
+		telemetry.Logger.Err(err).Ctx(ctx).Msg("MCP Error decoding rs when " + operation)
+		return nil, fmt.Errorf("%s: invalid response: %w", operation, err)
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      request.Params.URI,
+			MIMEType: documentMIMEType(document.Format),
+			Text:     document.Document,
+		},
+	}, nil
+}
+
+// documentMIMEType maps the upstream DocumentFormat to a MIME type.  Unknown or missing formats are served as plain text.
+func documentMIMEType(format cocktailsapi.DocumentFormat) string {
+	value, ok := format.(string)
+	if !ok {
+		return "text/plain"
+	}
+
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "markdown", "md":
+		return "text/markdown"
+	case "html":
+		return "text/html"
+	default:
+		return "text/plain"
+	}
+}
@@ -0,0 +1,147 @@
+package resources_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/resources"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+)
+
+func Test_privacy_policy_resource_returns_markdown_document(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, _ := testutils.Setup(t)
+
+	mux.HandleFunc("/api/v1/legal/documents/privacy-policy", func(w http.ResponseWriter, r *http.Request) {
+		testutils.TestMethod(t, r, "GET")
+		fmt.Fprint(w, `{"document":"# Privacy Policy","format":"markdown"}`)
+	})
+
+	request := mcp.ReadResourceRequest{
+		Params: mcp.ReadResourceParams{
+			URI: resources.PrivacyPolicyResourceURI,
+		},
+	}
+
+	handler := resources.NewLegalResourceHandler(client)
+
+	// act
+	contents, err := handler.HandlePrivacyPolicy(ctx, request)
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, contents, 1)
+
+	text, ok := contents[0].(mcp.TextResourceContents)
+	require.True(t, ok, "Contents should be of type TextResourceContents")
+	require.Equal(t, resources.PrivacyPolicyResourceURI, text.URI)
+	require.Equal(t, "text/markdown", text.MIMEType)
+	require.Equal(t, "# Privacy Policy", text.Text)
+}
+
+func Test_terms_of_service_resource_derives_mime_type_from_format(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		format   string
+		mimeType string
+	}{
+		{format: `"html"`, mimeType: "text/html"},
+		{format: `"Markdown"`, mimeType: "text/markdown"},
+		{format: `"text"`, mimeType: "text/plain"},
+		{format: `null`, mimeType: "text/plain"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			// arrange
+			t.Parallel()
+			testutils.LoadEnvironment("..", "..")
+			client, _, mux, ctx, _ := testutils.Setup(t)
+
+			mux.HandleFunc("/api/v1/legal/documents/terms-of-service", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{"document":"terms","format":%s}`, tt.format)
+			})
+
+			request := mcp.ReadResourceRequest{
+				Params: mcp.ReadResourceParams{
+					URI: resources.TermsOfServiceResourceURI,
+				},
+			}
+
+			handler := resources.NewLegalResourceHandler(client)
+
+			// act
+			contents, err := handler.HandleTermsOfService(ctx, request)
+
+			// assert
+			require.NoError(t, err)
+			require.Len(t, contents, 1)
+
+			text, ok := contents[0].(mcp.TextResourceContents)
+			require.True(t, ok, "Contents should be of type TextResourceContents")
+			require.Equal(t, tt.mimeType, text.MIMEType)
+			require.Equal(t, "terms", text.Text)
+		})
+	}
+}
+
+func Test_legal_resources_do_not_declare_a_mime_type_before_the_document_is_read(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, _ := testutils.Setup(t)
+
+	mux.HandleFunc("/api/v1/legal/documents/privacy-policy", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"document":"<h1>Privacy Policy</h1>","format":"html"}`)
+	})
+
+	handler := resources.NewLegalResourceHandler(client)
+
+	// act
+	contents, err := handler.HandlePrivacyPolicy(ctx, mcp.ReadResourceRequest{
+		Params: mcp.ReadResourceParams{
+			URI: resources.PrivacyPolicyResource.URI,
+		},
+	})
+
+	// assert
+	require.Empty(t, resources.PrivacyPolicyResource.MIMEType)
+	require.Empty(t, resources.TermsOfServiceResource.MIMEType)
+
+	require.NoError(t, err)
+	require.Len(t, contents, 1)
+	require.Equal(t, "text/html", contents[0].(mcp.TextResourceContents).MIMEType)
+}
+
+func Test_privacy_policy_resource_returns_error_on_upstream_failure(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, _ := testutils.Setup(t)
+
+	mux.HandleFunc("/api/v1/legal/documents/privacy-policy", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "boom", http.StatusInternalServerError)
+	})
+
+	request := mcp.ReadResourceRequest{
+		Params: mcp.ReadResourceParams{
+			URI: resources.PrivacyPolicyResourceURI,
+		},
+	}
+
+	handler := resources.NewLegalResourceHandler(client)
+
+	// act
+	contents, err := handler.HandlePrivacyPolicy(ctx, request)
+
+	// assert
+	require.Nil(t, contents)
+	require.ErrorContains(t, err, "status 500")
+}
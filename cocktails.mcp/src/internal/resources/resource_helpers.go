@@ -0,0 +1,48 @@
+// Package resources provides MCP resource and resource template implementations
+// for the Cezzi Cocktails MCP server. Resources let MCP clients attach Cezzis.com
+// content, such as curated cocktail collections, as context without a tool call.
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/upstream"
+)
+
+// resourceArgument returns the value of a URI template variable matched for a resource template read request.
+func resourceArgument(request mcp.ReadResourceRequest, name string) (string, error) {
+	var value string
+
+	switch v := request.Params.Arguments[name].(type) {
+	case string:
+		value = v
+	case []string:
+		if len(v) > 0 {
+			value = v[0]
+		}
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", fmt.Errorf("resource uri %q is missing the %q value", request.Params.URI, name)
+	}
+
+	return value, nil
+}
+
+// readResource calls the upstream API and returns the response body read with upstream.ReadResponse.  It returns an
+// error if the call failed or the upstream API responded with a non-success status code.
+func readResource(ctx context.Context, operation string, call func(ctx context.Context) (*http.Response, error)) ([]byte, error) {
+	callCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	rs, err := call(callCtx)
+
+	return upstream.ReadResponse(ctx, rs, err, operation)
+}
@@ -0,0 +1,348 @@
+// Package shopping builds shopping lists for a set of cocktails.  The ingredients of every cocktail are scaled to
+// the requested servings and totalled, amounts are converted to a single unit of volume, anything already stocked
+// in a bar is set aside and what is left to buy is grouped by the cocktail ingredient filter categories, such as
+// spirits, citrus and syrups.  Items to buy note any stocked sibling in the bar that might be used instead.
+package shopping
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/inventory"
+	"cezzis.com/cezzis-mcp-server/internal/units"
+)
+
+// OtherCategory is the category of ingredients that do not belong to any of the filter categories.
+const OtherCategory = "Other"
+
+// FilterCategory is one of the ingredient categories of the cocktails API ingredient filters.
+type FilterCategory struct {
+	// Key is the JSON field of the category in the ingredient filters response.
+	Key  string
+	Name string
+}
+
+// FilterCategories are the ingredient categories of the cocktails API ingredient filters, in the order they are
+// shopped.  The eras filters describe cocktails rather than ingredients and are not included.
+var FilterCategories = []FilterCategory{
+	{Key: "spirits", Name: "Spirits"},
+	{Key: "liqueursAperitifsAndAmari", Name: "Liqueurs, Aperitifs & Amari"},
+	{Key: "wineBeerAndSake", Name: "Wine, Beer & Sake"},
+	{Key: "bitters", Name: "Bitters"},
+	{Key: "fruitsAndCitrus", Name: "Fruits & Citrus"},
+	{Key: "juicesAndPurees", Name: "Juices & Purees"},
+	{Key: "sweetenersAndSyrups", Name: "Sweeteners & Syrups"},
+	{Key: "mixersSodaAndWater", Name: "Mixers, Soda & Water"},
+	{Key: "dairyAndEggs", Name: "Dairy & Eggs"},
+	{Key: "aromaticsTeaAndCoffee", Name: "Aromatics, Tea & Coffee"},
+	{Key: "savoryBrinyAndHeat", Name: "Savory, Briny & Heat"},
+	{Key: "vegetablesAndSavoryProduce", Name: "Vegetables & Savory Produce"},
+}
+
+// Categories assigns ingredients to the filter categories.
+type Categories struct {
+	byFilter map[string]string
+	byKey    map[string]string
+}
+
+// NewCategories creates Categories from the ingredient filters keyed by their category's JSON field, as returned
+// by the cocktails API ingredient filters.
+func NewCategories(filters map[string][]cocktailsapi.IngredientFilterModel) *Categories {
+	categories := &Categories{
+		byFilter: map[string]string{},
+		byKey:    make(map[string]string, len(FilterCategories)),
+	}
+
+	for _, category := range FilterCategories {
+		categories.byKey[compact(category.Key)] = category.Name
+
+		for _, filter := range filters[category.Key] {
+			for _, value := range []string{filter.Id, filter.Name} {
+				if key := normalize(value); key != "" {
+					categories.byFilter[key] = category.Name
+				}
+			}
+		}
+	}
+
+	return categories
+}
+
+// Assign returns the name of the ingredient's category, checking its id and name, then its taxonomy types from most
+// to least specific and finally its taxonomy filter type.  Ingredients without a category are assigned OtherCategory.
+func (categories *Categories) Assign(ingredient cocktailsapi.IngredientModel) string {
+	if categories == nil {
+		return OtherCategory
+	}
+
+	for _, value := range []string{ingredient.Id, ingredient.Name} {
+		if name, ok := categories.byFilter[normalize(value)]; ok {
+			return name
+		}
+	}
+
+	for i := len(ingredient.Types) - 1; i >= 0; i-- {
+		if name, ok := categories.byFilter[normalize(ingredient.Types[i])]; ok {
+			return name
+		}
+	}
+
+	if ingredient.TaxonomyFilterType != nil {
+		if name, ok := categories.byKey[compact(fmt.Sprint(ingredient.TaxonomyFilterType))]; ok {
+			return name
+		}
+	}
+
+	return OtherCategory
+}
+
+// Order is a cocktail and the number of servings of it to shop for.
+type Order struct {
+	Cocktail cocktailsapi.CocktailModel
+	Servings float64
+}
+
+// Options configure a shopping list.
+type Options struct {
+	// Unit is the unit of volume amounts are shown in, either units.Ounces or units.Milliliters.  Defaults to ounces.
+	Unit units.Unit
+	// Conventions are the sizes of bar measures such as dashes.  Defaults to units.DefaultConventions.
+	Conventions *units.Conventions
+	// Categories assign the ingredients to groups.  When nil every ingredient is in OtherCategory.
+	Categories *Categories
+	// Shelf holds the ingredients already stocked in a bar, which are set aside rather than bought.  May be nil.
+	Shelf *inventory.Shelf
+	// Parents are the catalog parents of recipe ingredients keyed by ingredient id, which allow a stocked parent to
+	// cover an ingredient on the Shelf and a stocked sibling to be noted as a substitute.  Ingredients without a
+	// parent are only covered by an exact match or a more specific bottle.
+	Parents map[string]string
+}
+
+// Cocktail is a cocktail on a shopping list.
+type Cocktail struct {
+	ID       string  `json:"id"`
+	Title    string  `json:"title"`
+	Servings float64 `json:"servings"`
+}
+
+// Item is the total of one ingredient across every cocktail on a shopping list.
+type Item struct {
+	ID       string  `json:"id,omitempty"`
+	Name     string  `json:"name"`
+	Category string  `json:"category"`
+	Amount   float64 `json:"amount,omitempty"`
+	Unit     string  `json:"unit,omitempty"`
+	// Count is the number of whole items, such as eggs or lime wedges, rounded up.
+	Count float64 `json:"count,omitempty"`
+	// AsNeeded is set for ingredients used to taste or to top off, which have no amount to total.
+	AsNeeded  bool     `json:"asNeeded,omitempty"`
+	Display   string   `json:"display"`
+	Cocktails []string `json:"cocktails"`
+	// InBar is the name of the stocked bar ingredient that covers this item.
+	InBar string `json:"inBar,omitempty"`
+	// Substitute is the name of a stocked bar ingredient sharing this item's parent, such as an aged rum for a white
+	// rum, that might be used instead.  The item is still listed to buy.
+	Substitute string `json:"substitute,omitempty"`
+}
+
+// Group is the items to buy in one category.
+type Group struct {
+	Category string `json:"category"`
+	Items    []Item `json:"items"`
+}
+
+// List is a shopping list for a set of cocktails.
+type List struct {
+	Unit      string     `json:"unit"`
+	Cocktails []Cocktail `json:"cocktails"`
+	ToBuy     []Group    `json:"toBuy"`
+	InBar     []Item     `json:"inBar,omitempty"`
+}
+
+// total accumulates the amounts of one ingredient before it is converted to an Item.
+type total struct {
+	item       Item
+	ml         float64
+	count      float64
+	unmeasured bool
+}
+
+// Build totals the ingredients of the orders into a shopping list.
+func Build(orders []Order, options Options) (*List, error) {
+	target := options.Unit
+	if target == "" {
+		target = units.Ounces
+	}
+	if target != units.Ounces && target != units.Milliliters {
+		return nil, fmt.Errorf("shopping lists can only be shown in %s or %s", units.Ounces, units.Milliliters)
+	}
+
+	conventions := units.DefaultConventions()
+	if options.Conventions != nil {
+		conventions = *options.Conventions
+	}
+
+	list := &List{
+		Unit:      units.Label(target, 2),
+		Cocktails: make([]Cocktail, 0, len(orders)),
+		ToBuy:     []Group{},
+	}
+
+	totals := map[string]*total{}
+	keys := []string{}
+
+	for _, order := range orders {
+		if order.Servings <= 0 {
+			return nil, fmt.Errorf("the servings of cocktail %s must be greater than zero", order.Cocktail.Id)
+		}
+
+		list.Cocktails = append(list.Cocktails, Cocktail{
+			ID:       order.Cocktail.Id,
+			Title:    order.Cocktail.Title,
+			Servings: order.Servings,
+		})
+
+		factor := order.Servings / float64(max(order.Cocktail.Serves, 1))
+
+		for _, ingredient := range order.Cocktail.Ingredients {
+			key := normalize(ingredient.Id)
+			if key == "" {
+				key = normalize(ingredient.Name)
+			}
+			if key == "" {
+				continue
+			}
+
+			entry, ok := totals[key]
+			if !ok {
+				entry = &total{item: Item{
+					ID:       ingredient.Id,
+					Name:     ingredient.Name,
+					Category: options.Categories.Assign(ingredient),
+				}}
+				totals[key] = entry
+				keys = append(keys, key)
+
+				if options.Shelf != nil {
+					stocked := inventory.RecipeIngredient{
+						ID:       ingredient.Id,
+						Name:     ingredient.Name,
+						ParentID: options.Parents[ingredient.Id],
+					}
+
+					if bottle, _, found := options.Shelf.Find(stocked); found {
+						entry.item.InBar = bottle.Name
+					} else if substitute, ok := options.Shelf.Substitute(stocked); ok {
+						entry.item.Substitute = substitute.Name
+					}
+				}
+			}
+
+			if !slices.Contains(entry.item.Cocktails, order.Cocktail.Title) {
+				entry.item.Cocktails = append(entry.item.Cocktails, order.Cocktail.Title)
+			}
+
+			entry.add(ingredient, factor, conventions)
+		}
+	}
+
+	groups := map[string][]Item{}
+	for _, key := range keys {
+		item := totals[key].finish(target, conventions)
+
+		if item.InBar != "" {
+			list.InBar = append(list.InBar, item)
+			continue
+		}
+
+		groups[item.Category] = append(groups[item.Category], item)
+	}
+
+	names := make([]string, 0, len(FilterCategories)+1)
+	for _, category := range FilterCategories {
+		names = append(names, category.Name)
+	}
+
+	for _, name := range append(names, OtherCategory) {
+		if items := groups[name]; len(items) > 0 {
+			slices.SortStableFunc(items, compareItems)
+			list.ToBuy = append(list.ToBuy, Group{Category: name, Items: items})
+		}
+	}
+
+	slices.SortStableFunc(list.InBar, compareItems)
+
+	return list, nil
+}
+
+// add accumulates one serving-scaled use of the ingredient.
+func (entry *total) add(ingredient cocktailsapi.IngredientModel, factor float64, conventions units.Conventions) {
+	uom, _ := ingredient.UoM.(string)
+	unit, err := units.ParseUnit(uom)
+	amount := float64(ingredient.Units) * factor
+
+	switch {
+	case err != nil || amount <= 0 || !units.Scalable(unit):
+		entry.unmeasured = true
+	case unit == units.Item:
+		entry.count += amount
+	default:
+		ml, err := conventions.Milliliters(amount, unit)
+		if err != nil {
+			entry.unmeasured = true
+			return
+		}
+		entry.ml += ml
+	}
+}
+
+// finish converts the accumulated amounts into an Item in the target unit.
+func (entry *total) finish(target units.Unit, conventions units.Conventions) Item {
+	item := entry.item
+	parts := []string{}
+
+	if entry.ml > 0 {
+		amount, _ := conventions.Convert(entry.ml, units.Milliliters, target)
+		item.Amount = units.Friendly(amount, target)
+		item.Unit = units.Label(target, 2)
+		parts = append(parts, units.FormatAmount(item.Amount, target)+" "+units.Label(target, item.Amount))
+	}
+
+	if entry.count > 0 {
+		item.Count = math.Ceil(entry.count - 1e-9)
+		parts = append(parts, fmt.Sprintf("%d", int(item.Count)))
+	}
+
+	if len(parts) == 0 {
+		item.AsNeeded = entry.unmeasured
+		parts = append(parts, "as needed")
+	}
+
+	item.Display = strings.Join(parts, " + ") + " " + item.Name
+
+	return item
+}
+
+// compareItems orders items by name.
+func compareItems(a, b Item) int {
+	return cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+}
+
+// normalize lowercases the value and collapses punctuation and whitespace into single spaces, so ingredient ids
+// such as "london-dry-gin" and names such as "London Dry Gin" compare equal.
+func normalize(value string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(value), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// compact lowercases the value and removes everything but letters and digits, so category keys such as
+// "fruitsAndCitrus" and "FruitsAndCitrus" compare equal.
+func compact(value string) string {
+	return strings.ReplaceAll(normalize(value), " ", "")
+}
@@ -0,0 +1,199 @@
+package shopping_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/inventory"
+	"cezzis.com/cezzis-mcp-server/internal/shopping"
+	"cezzis.com/cezzis-mcp-server/internal/units"
+)
+
+func categories() *shopping.Categories {
+	return shopping.NewCategories(map[string][]cocktailsapi.IngredientFilterModel{
+		"spirits":             {{Id: "gin", Name: "Gin"}, {Id: "rum", Name: "Rum"}},
+		"fruitsAndCitrus":     {{Id: "lime", Name: "Lime"}},
+		"sweetenersAndSyrups": {{Id: "simple-syrup", Name: "Simple Syrup"}},
+		"dairyAndEggs":        {{Id: "egg-white", Name: "Egg White"}},
+	})
+}
+
+func daiquiri() cocktailsapi.CocktailModel {
+	return cocktailsapi.CocktailModel{
+		Id:     "daiquiri",
+		Title:  "Daiquiri",
+		Serves: 1,
+		Ingredients: []cocktailsapi.IngredientModel{
+			{Id: "white-rum", Name: "White Rum", Types: []string{"Spirits", "Rum"}, Units: 2, UoM: "ounces"},
+			{Id: "lime-juice", Name: "Lime Juice", Types: []string{"Citrus", "Lime"}, Units: 1, UoM: "ounces"},
+			{Id: "simple-syrup", Name: "Simple Syrup", Units: 0.75, UoM: "ounces"},
+		},
+	}
+}
+
+func gimlet() cocktailsapi.CocktailModel {
+	return cocktailsapi.CocktailModel{
+		Id:     "gimlet",
+		Title:  "Gimlet",
+		Serves: 2,
+		Ingredients: []cocktailsapi.IngredientModel{
+			{Id: "london-dry-gin", Name: "London Dry Gin", Types: []string{"Gin"}, Units: 4, UoM: "ounces"},
+			{Id: "lime-juice", Name: "Lime Juice", Types: []string{"Citrus", "Lime"}, Units: 1.5, UoM: "ounces"},
+			{Id: "lime-wheel", Name: "Lime Wheel", TaxonomyFilterType: "FruitsAndCitrus", Units: 1, UoM: "item"},
+			{Id: "egg-white", Name: "Egg White", Units: 1, UoM: "item"},
+			{Name: "Soda Water", UoM: "topoff"},
+		},
+	}
+}
+
+func findItem(list *shopping.List, name string) (shopping.Item, string) {
+	for _, group := range list.ToBuy {
+		for _, item := range group.Items {
+			if item.Name == name {
+				return item, group.Category
+			}
+		}
+	}
+	return shopping.Item{}, ""
+}
+
+func Test_categories_assign_by_id_name_types_and_taxonomy_filter_type(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		ingredient cocktailsapi.IngredientModel
+		expected   string
+	}{
+		{"filter id", cocktailsapi.IngredientModel{Id: "simple-syrup"}, "Sweeteners & Syrups"},
+		{"filter name", cocktailsapi.IngredientModel{Name: "Gin"}, "Spirits"},
+		{"most specific type", cocktailsapi.IngredientModel{Id: "white-rum", Types: []string{"Citrus", "Rum"}}, "Spirits"},
+		{"taxonomy filter type", cocktailsapi.IngredientModel{Id: "orange-peel", TaxonomyFilterType: "fruits-and-citrus"}, "Fruits & Citrus"},
+		{"unknown", cocktailsapi.IngredientModel{Id: "ice"}, shopping.OtherCategory},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.expected, categories().Assign(test.ingredient), test.name)
+	}
+
+	var none *shopping.Categories
+	require.Equal(t, shopping.OtherCategory, none.Assign(daiquiri().Ingredients[0]))
+}
+
+func Test_build_totals_ingredients_across_cocktails_and_servings(t *testing.T) {
+	t.Parallel()
+
+	// act
+	list, err := shopping.Build([]shopping.Order{
+		{Cocktail: daiquiri(), Servings: 4},
+		{Cocktail: gimlet(), Servings: 3},
+	}, shopping.Options{Categories: categories()})
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, "oz", list.Unit)
+	require.Len(t, list.Cocktails, 2)
+	require.Empty(t, list.InBar)
+
+	lime, category := findItem(list, "Lime Juice")
+	require.Equal(t, "Fruits & Citrus", category)
+	require.InDelta(t, 6.25, lime.Amount, 0.001)
+	require.Equal(t, "6 1/4 oz Lime Juice", lime.Display)
+	require.Equal(t, []string{"Daiquiri", "Gimlet"}, lime.Cocktails)
+
+	gin, category := findItem(list, "London Dry Gin")
+	require.Equal(t, "Spirits", category)
+	require.InDelta(t, 6.0, gin.Amount, 0.001)
+
+	egg, category := findItem(list, "Egg White")
+	require.Equal(t, "Dairy & Eggs", category)
+	require.InDelta(t, 2.0, egg.Count, 0.001)
+	require.Equal(t, "2 Egg White", egg.Display)
+
+	soda, category := findItem(list, "Soda Water")
+	require.Equal(t, shopping.OtherCategory, category)
+	require.True(t, soda.AsNeeded)
+	require.Equal(t, "as needed Soda Water", soda.Display)
+
+	categoriesInOrder := []string{}
+	for _, group := range list.ToBuy {
+		categoriesInOrder = append(categoriesInOrder, group.Category)
+	}
+	require.Equal(t, []string{"Spirits", "Fruits & Citrus", "Sweeteners & Syrups", "Dairy & Eggs", shopping.OtherCategory}, categoriesInOrder)
+}
+
+func Test_build_converts_to_milliliters(t *testing.T) {
+	t.Parallel()
+
+	// act
+	list, err := shopping.Build([]shopping.Order{{Cocktail: daiquiri(), Servings: 1}}, shopping.Options{Unit: units.Milliliters})
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, "ml", list.Unit)
+	require.Len(t, list.ToBuy, 1)
+
+	rum, category := findItem(list, "White Rum")
+	require.Equal(t, shopping.OtherCategory, category)
+	require.InDelta(t, 60.0, rum.Amount, 0.001)
+	require.Equal(t, "ml", rum.Unit)
+	require.Equal(t, "60 ml White Rum", rum.Display)
+}
+
+func Test_build_sets_aside_ingredients_in_the_bar(t *testing.T) {
+	t.Parallel()
+
+	shelf := inventory.NewShelf([]inventory.Bottle{
+		{ID: "white-rum", Name: "White Rum"},
+		{ID: "simple-syrup", Name: "Rich Simple Syrup"},
+	})
+
+	// act
+	list, err := shopping.Build([]shopping.Order{{Cocktail: daiquiri(), Servings: 2}}, shopping.Options{Categories: categories(), Shelf: shelf})
+
+	// assert
+	require.NoError(t, err)
+	require.Len(t, list.InBar, 2)
+	require.Equal(t, "Simple Syrup", list.InBar[0].Name)
+	require.Equal(t, "Rich Simple Syrup", list.InBar[0].InBar)
+	require.Equal(t, "White Rum", list.InBar[1].Name)
+
+	require.Len(t, list.ToBuy, 1)
+	require.Equal(t, "Lime Juice", list.ToBuy[0].Items[0].Name)
+}
+
+func Test_build_rejects_invalid_servings_and_units(t *testing.T) {
+	t.Parallel()
+
+	_, err := shopping.Build([]shopping.Order{{Cocktail: daiquiri(), Servings: 0}}, shopping.Options{})
+	require.EqualError(t, err, "the servings of cocktail daiquiri must be greater than zero")
+
+	_, err = shopping.Build([]shopping.Order{{Cocktail: daiquiri(), Servings: 1}}, shopping.Options{Unit: units.Cups})
+	require.EqualError(t, err, "shopping lists can only be shown in ounces or ml")
+}
+
+func Test_build_notes_a_stocked_sibling_as_a_substitute(t *testing.T) {
+	t.Parallel()
+
+	shelf := inventory.NewShelf([]inventory.Bottle{{ID: "aged-rum", Name: "Aged Rum", ParentID: "rum"}})
+
+	// act
+	without, err := shopping.Build([]shopping.Order{{Cocktail: daiquiri(), Servings: 1}}, shopping.Options{Shelf: shelf})
+	require.NoError(t, err)
+
+	with, err := shopping.Build([]shopping.Order{{Cocktail: daiquiri(), Servings: 1}}, shopping.Options{
+		Shelf:   shelf,
+		Parents: map[string]string{"white-rum": "rum"},
+	})
+	require.NoError(t, err)
+
+	// assert
+	require.Empty(t, without.InBar)
+	require.Empty(t, without.ToBuy[0].Items[2].Substitute)
+
+	require.Empty(t, with.InBar)
+	require.Equal(t, "White Rum", with.ToBuy[0].Items[2].Name)
+	require.Equal(t, "Aged Rum", with.ToBuy[0].Items[2].Substitute)
+}
@@ -0,0 +1,232 @@
+package strength
+
+import (
+	"strings"
+	"unicode"
+)
+
+// abvReference is the typical alcohol by volume, in percent, of common cocktail ingredients.  It is keyed by
+// normalized ingredient ids, taxonomy types and name phrases, so "london-dry-gin", "London Dry Gin" and the type
+// "london dry gin" all share an entry.  Entries with a zero ABV exist so that non-alcoholic ingredients such as
+// ginger beer are not mistaken for the spirits or beers in their names.
+var abvReference = map[string]float64{
+	// Spirits
+	"spirits":            40,
+	"whiskey":            43,
+	"whisky":             43,
+	"bourbon":            45,
+	"rye":                45,
+	"rye whiskey":        45,
+	"scotch":             43,
+	"irish whiskey":      40,
+	"japanese whisky":    43,
+	"gin":                42,
+	"london dry gin":     45,
+	"old tom gin":        42,
+	"navy strength gin":  57,
+	"genever":            38,
+	"vodka":              40,
+	"rum":                40,
+	"white rum":          40,
+	"dark rum":           40,
+	"aged rum":           40,
+	"overproof rum":      63,
+	"rhum agricole":      50,
+	"cachaca":            40,
+	"tequila":            40,
+	"mezcal":             45,
+	"brandy":             40,
+	"cognac":             40,
+	"armagnac":           40,
+	"calvados":           40,
+	"apple brandy":       45,
+	"applejack":          40,
+	"pisco":              40,
+	"grappa":             40,
+	"eau de vie":         40,
+	"kirsch":             40,
+	"absinthe":           60,
+	"aquavit":            40,
+	"arrack":             50,
+	"batavia arrack":     50,
+	"baijiu":             52,
+	"shochu":             25,
+	"soju":               17,
+	"sake":               15,
+	"moonshine":          50,
+	"grain alcohol":      95,
+	"neutral spirit":     95,
+	"overproof":          63,
+	"high proof":         57,
+	"cask strength":      57,
+	"barrel proof":       57,
+	"bottled in bond":    50,
+	"white whiskey":      45,
+	"blended scotch":     40,
+	"single malt scotch": 43,
+
+	// Liqueurs, aperitifs and amari
+	"liqueurs":           25,
+	"liqueur":            25,
+	"amaro":              28,
+	"amari":              28,
+	"aperitif":           15,
+	"aperitifs":          15,
+	"campari":            24,
+	"aperol":             11,
+	"cynar":              16.5,
+	"fernet":             39,
+	"fernet branca":      39,
+	"chartreuse":         55,
+	"green chartreuse":   55,
+	"yellow chartreuse":  40,
+	"benedictine":        40,
+	"drambuie":           40,
+	"galliano":           30,
+	"maraschino":         32,
+	"maraschino liqueur": 32,
+	"triple sec":         30,
+	"cointreau":          40,
+	"grand marnier":      40,
+	"curacao":            25,
+	"orange curacao":     25,
+	"dry curacao":        40,
+	"blue curacao":       25,
+	"orange liqueur":     35,
+	"falernum":           11,
+	"velvet falernum":    11,
+	"creme de cacao":     25,
+	"creme de menthe":    25,
+	"creme de violette":  16,
+	"creme de cassis":    16,
+	"creme de mure":      16,
+	"creme de peche":     18,
+	"creme de banane":    20,
+	"coffee liqueur":     20,
+	"kahlua":             20,
+	"amaretto":           28,
+	"elderflower":        20,
+	"st germain":         20,
+	"allspice dram":      22,
+	"pimento dram":       22,
+	"cherry heering":     24,
+	"cherry liqueur":     24,
+	"apricot liqueur":    24,
+	"apricot brandy":     24,
+	"sloe gin":           26,
+	"frangelico":         20,
+	"chambord":           16.5,
+	"irish cream":        17,
+	"limoncello":         28,
+	"sambuca":            40,
+	"anisette":           25,
+	"pastis":             45,
+	"pernod":             40,
+	"herbsaint":          45,
+	"midori":             20,
+	"passoa":             17,
+	"pimms":              25,
+	"ginger liqueur":     20,
+	"kummel":             38,
+
+	// Wines and fortified wines
+	"fortified wine":   18,
+	"fortified wines":  18,
+	"vermouth":         17,
+	"sweet vermouth":   16,
+	"dry vermouth":     18,
+	"blanc vermouth":   16,
+	"bianco vermouth":  16,
+	"lillet":           17,
+	"lillet blanc":     17,
+	"cocchi americano": 16.5,
+	"quinquina":        17,
+	"dubonnet":         15,
+	"byrrh":            18,
+	"sherry":           17,
+	"fino sherry":      15,
+	"amontillado":      18,
+	"oloroso":          18,
+	"pedro ximenez":    17,
+	"port":             20,
+	"ruby port":        20,
+	"tawny port":       20,
+	"madeira":          19,
+	"marsala":          18,
+	"wine":             12,
+	"wines":            12,
+	"red wine":         13,
+	"white wine":       12,
+	"rose wine":        12,
+	"sparkling wine":   12,
+	"champagne":        12,
+	"prosecco":         11,
+	"cava":             11.5,
+
+	// Beer and cider
+	"beer":       5,
+	"lager":      5,
+	"ale":        5.5,
+	"stout":      6,
+	"porter":     6,
+	"ipa":        6.5,
+	"cider":      5,
+	"hard cider": 5,
+
+	// Bitters are strong, though little is used
+	"bitters":           44,
+	"angostura":         44.7,
+	"angostura bitters": 44.7,
+	"peychauds":         35,
+	"peychauds bitters": 35,
+	"orange bitters":    28,
+	"aromatic bitters":  44,
+
+	// Non-alcoholic ingredients whose names contain alcoholic ones
+	"ginger beer":     0,
+	"ginger ale":      0,
+	"root beer":       0,
+	"non alcoholic":   0,
+	"alcohol free":    0,
+	"zero proof":      0,
+	"wine vinegar":    0,
+	"rum extract":     0,
+	"vanilla extract": 0,
+}
+
+// normalize lowercases the value, strips accents and apostrophes and collapses punctuation and whitespace into single
+// spaces, so ids, taxonomy types and display names can be compared.
+func normalize(value string) string {
+	replacer := strings.NewReplacer("é", "e", "è", "e", "ê", "e", "ç", "c", "ü", "u", "'", "", "’", "")
+	value = replacer.Replace(strings.ToLower(value))
+
+	return strings.Join(strings.FieldsFunc(value, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// lookupExact returns the reference ABV for the value if it is a known key.
+func lookupExact(value string) (float64, bool) {
+	abv, ok := abvReference[normalize(value)]
+	return abv, ok
+}
+
+// lookupPhrase returns the reference ABV for the longest known key that appears as a whole phrase in the name, so
+// "Green Chartreuse" matches "green chartreuse" rather than "chartreuse" and "Ginger Beer" never matches "gin".
+func lookupPhrase(name string) (string, float64, bool) {
+	padded := " " + normalize(name) + " "
+
+	best, found := "", false
+	for key := range abvReference {
+		if !strings.Contains(padded, " "+key+" ") {
+			continue
+		}
+
+		// Prefer the longest phrase, breaking ties alphabetically so the match is deterministic
+		if !found || len(key) > len(best) || (len(key) == len(best) && key < best) {
+			best, found = key, true
+		}
+	}
+
+	return best, abvReference[best], found
+}
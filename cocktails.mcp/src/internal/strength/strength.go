@@ -0,0 +1,259 @@
+// Package strength estimates how strong a cocktail is: its alcohol by volume after dilution, its final volume and
+// the number of standard drinks it contains.  Ingredient strengths come from a local reference table of typical
+// ABVs, and dilution is estimated from the cocktail's mixing technique and starting strength, so results are
+// estimates rather than measurements.
+package strength
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/units"
+)
+
+const (
+	// EthanolGramsPerMl is the density of ethanol.
+	EthanolGramsPerMl = 0.789
+	// DefaultStandardDrinkGrams is the grams of ethanol in a US standard drink.
+	DefaultStandardDrinkGrams = 14
+)
+
+// Techniques that determine how much a cocktail is diluted by melting ice.
+const (
+	Shaken   = "shaken"
+	Stirred  = "stirred"
+	Built    = "built"
+	Blended  = "blended"
+	Thrown   = "thrown"
+	Swizzled = "swizzled"
+	// Unknown is used when the technique cannot be determined, in which case no dilution is applied.
+	Unknown = "unknown"
+)
+
+// dilutionCurves give the volume of melted ice, as a fraction of the undiluted volume, that shaking and stirring
+// add for a drink of the given undiluted ABV (as a fraction).  They are Dave Arnold's fits to his measurements of
+// drinks shaken or stirred with 1 1/4" cubes until fully chilled, published in Liquid Intelligence (2014), "Shaken
+// and Stirred Drinks" chapter.  Stronger drinks melt more ice when shaken but less when stirred.
+var dilutionCurves = map[string]func(abv float64) float64{
+	Shaken:  func(abv float64) float64 { return 1.567*abv*abv + 1.742*abv + 0.203 },
+	Stirred: func(abv float64) float64 { return -1.21*abv*abv + 1.246*abv + 0.145 },
+}
+
+// dilutionRates are the dilution of the techniques without a published curve, as a fraction of the undiluted volume.
+// These are approximations: a built drink served over ice melts about as much as a short stir before it is drunk
+// and a blended drink takes in roughly half its volume in ice.  Thrown and swizzled drinks use the stirred and
+// shaken curves respectively.
+var dilutionRates = map[string]float64{
+	Built:   0.25,
+	Blended: 0.50,
+}
+
+// techniqueStems match technique keywords in their various forms, such as "Stir", "stirred" or "shake & strain".
+var techniqueStems = []struct {
+	stem      string
+	technique string
+}{
+	{"shak", Shaken},
+	{"stir", Stirred},
+	{"buil", Built},
+	{"blend", Blended},
+	{"throw", Thrown},
+	{"swizzl", Swizzled},
+}
+
+// Techniques returns the techniques that have a known dilution.
+func Techniques() []string {
+	return []string{Shaken, Stirred, Built, Blended, Thrown, Swizzled}
+}
+
+// ParseTechnique returns the technique named by the keyword, such as "Stir" or "shake & strain".
+func ParseTechnique(keyword string) (string, bool) {
+	normalized := strings.ToLower(keyword)
+
+	for _, candidate := range techniqueStems {
+		if strings.Contains(normalized, candidate.stem) {
+			return candidate.technique, true
+		}
+	}
+
+	return "", false
+}
+
+// DilutionRate returns the typical dilution of the technique, as a fraction of the undiluted volume, for a drink
+// whose undiluted ABV is the given percentage.  Unknown techniques are not diluted.
+func DilutionRate(technique string, abv float64) float64 {
+	switch technique {
+	case Thrown:
+		technique = Stirred
+	case Swizzled:
+		technique = Shaken
+	}
+
+	if curve, ok := dilutionCurves[technique]; ok {
+		return max(curve(abv/100), 0)
+	}
+
+	return dilutionRates[technique]
+}
+
+// Options configure an estimate.
+type Options struct {
+	// Technique overrides the technique taken from the cocktail's keywords when not empty.
+	Technique string
+	// StandardDrinkGrams is the grams of ethanol in a standard drink.  Defaults to DefaultStandardDrinkGrams.
+	StandardDrinkGrams float64
+	// Conventions are the sizes of bar measures such as dashes.  Defaults to units.DefaultConventions.
+	Conventions *units.Conventions
+}
+
+// IngredientStrength is the alcohol contributed by a single measured ingredient.
+type IngredientStrength struct {
+	Name     string  `json:"name"`
+	VolumeMl float64 `json:"volumeMl"`
+	ABV      float64 `json:"abv"`
+	// MatchedOn is the reference entry the ABV came from, or empty if the ingredient is assumed to be non-alcoholic.
+	MatchedOn string `json:"matchedOn,omitempty"`
+}
+
+// Estimate is the estimated strength of a single serving of a cocktail.
+type Estimate struct {
+	Technique          string               `json:"technique"`
+	DilutionRate       float64              `json:"dilutionRate"`
+	UndilutedVolumeMl  float64              `json:"undilutedVolumeMl"`
+	UndilutedABV       float64              `json:"undilutedAbv"`
+	FinalVolumeMl      float64              `json:"finalVolumeMl"`
+	FinalVolumeOz      float64              `json:"finalVolumeOz"`
+	ABV                float64              `json:"abv"`
+	EthanolGrams       float64              `json:"ethanolGrams"`
+	StandardDrinks     float64              `json:"standardDrinks"`
+	StandardDrinkGrams float64              `json:"standardDrinkGrams"`
+	Ingredients        []IngredientStrength `json:"ingredients"`
+	// Unmeasured lists the ingredients without a volume, such as 'top off' ingredients, that are not included.
+	Unmeasured []string `json:"unmeasured,omitempty"`
+	Notes      []string `json:"notes,omitempty"`
+}
+
+// EstimateCocktail estimates the strength of a single serving of the cocktail.
+func EstimateCocktail(cocktail *cocktailsapi.CocktailModel, options Options) (*Estimate, error) {
+	conventions := units.DefaultConventions()
+	if options.Conventions != nil {
+		conventions = *options.Conventions
+	}
+
+	standardDrinkGrams := options.StandardDrinkGrams
+	if standardDrinkGrams == 0 {
+		standardDrinkGrams = DefaultStandardDrinkGrams
+	}
+	if standardDrinkGrams < 0 {
+		return nil, errors.New("the standard drink size must be greater than zero")
+	}
+
+	technique, err := resolveTechnique(cocktail.Keywords.KeywordsTechnique, options.Technique)
+	if err != nil {
+		return nil, err
+	}
+
+	serves := float64(max(cocktail.Serves, 1))
+
+	estimate := &Estimate{
+		Technique:          technique,
+		StandardDrinkGrams: standardDrinkGrams,
+		Ingredients:        make([]IngredientStrength, 0, len(cocktail.Ingredients)),
+	}
+
+	ethanolMl := 0.0
+	for _, ingredient := range cocktail.Ingredients {
+		uom, _ := ingredient.UoM.(string)
+		unit, _ := units.ParseUnit(uom)
+
+		ml, err := conventions.Milliliters(float64(ingredient.Units), unit)
+		if err != nil || ml <= 0 {
+			if unit == units.Topoff || unit == units.Discretion || unit == units.ToTaste {
+				estimate.Unmeasured = append(estimate.Unmeasured, ingredient.Name)
+			}
+			continue
+		}
+
+		ml /= serves
+		abv, matchedOn := IngredientABV(ingredient)
+
+		estimate.Ingredients = append(estimate.Ingredients, IngredientStrength{
+			Name:      ingredient.Name,
+			VolumeMl:  units.Round(ml, 0.1),
+			ABV:       abv,
+			MatchedOn: matchedOn,
+		})
+
+		estimate.UndilutedVolumeMl += ml
+		ethanolMl += ml * abv / 100
+	}
+
+	if estimate.UndilutedVolumeMl == 0 {
+		return nil, fmt.Errorf("cocktail %s has no measured ingredients to estimate its strength from", cocktail.Id)
+	}
+
+	rate := DilutionRate(technique, ethanolMl/estimate.UndilutedVolumeMl*100)
+	finalVolume := estimate.UndilutedVolumeMl * (1 + rate)
+	ethanolGrams := ethanolMl * EthanolGramsPerMl
+
+	estimate.DilutionRate = units.Round(rate, 0.01)
+	estimate.UndilutedABV = units.Round(ethanolMl/estimate.UndilutedVolumeMl*100, 0.1)
+	estimate.UndilutedVolumeMl = units.Round(estimate.UndilutedVolumeMl, 1)
+	estimate.FinalVolumeMl = units.Round(finalVolume, 1)
+	estimate.FinalVolumeOz = units.Round(finalVolume/units.MillilitersPerOunce, 0.1)
+	estimate.ABV = units.Round(ethanolMl/finalVolume*100, 0.1)
+	estimate.EthanolGrams = units.Round(ethanolGrams, 0.1)
+	estimate.StandardDrinks = units.Round(ethanolGrams/standardDrinkGrams, 0.1)
+
+	if technique == Unknown {
+		estimate.Notes = append(estimate.Notes, "The technique is unknown, so no dilution was applied and the ABV is likely overstated.")
+	}
+	if len(estimate.Unmeasured) > 0 {
+		estimate.Notes = append(estimate.Notes, "Unmeasured ingredients such as top offs are not included, so the final volume is understated.")
+	}
+
+	return estimate, nil
+}
+
+// IngredientABV returns the reference ABV of the ingredient and the reference entry it matched, checking its id,
+// then its taxonomy types from most to least specific, then phrases in its name.  Ingredients without a match are
+// assumed to be non-alcoholic and return an empty match.
+func IngredientABV(ingredient cocktailsapi.IngredientModel) (float64, string) {
+	if abv, ok := lookupExact(ingredient.Id); ok {
+		return abv, normalize(ingredient.Id)
+	}
+
+	for i := len(ingredient.Types) - 1; i >= 0; i-- {
+		if abv, ok := lookupExact(ingredient.Types[i]); ok {
+			return abv, normalize(ingredient.Types[i])
+		}
+	}
+
+	if key, abv, ok := lookupPhrase(ingredient.Name); ok {
+		return abv, key
+	}
+
+	return 0, ""
+}
+
+// resolveTechnique returns the technique, preferring the override and otherwise the first of the cocktail's
+// technique keywords that names a known technique.
+func resolveTechnique(keywords []string, override string) (string, error) {
+	if strings.TrimSpace(override) != "" {
+		technique, ok := ParseTechnique(override)
+		if !ok {
+			return "", fmt.Errorf("unknown technique %q; expected one of %s", override, strings.Join(Techniques(), ", "))
+		}
+		return technique, nil
+	}
+
+	for _, keyword := range keywords {
+		if technique, ok := ParseTechnique(keyword); ok {
+			return technique, nil
+		}
+	}
+
+	return Unknown, nil
+}
@@ -0,0 +1,158 @@
+package strength_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/strength"
+)
+
+func peguClub() *cocktailsapi.CocktailModel {
+	return &cocktailsapi.CocktailModel{
+		Id:     "pegu-club",
+		Serves: 1,
+		Ingredients: []cocktailsapi.IngredientModel{
+			{Name: "Gin", Units: 2, UoM: "ounces", Types: []string{"Spirits", "Gin"}},
+			{Name: "Orange Curacao", Units: 0.75, UoM: "ounces"},
+			{Name: "Lime Juice", Units: 0.75, UoM: "ounces"},
+			{Name: "Angostura Bitters", Units: 1, UoM: "dashes"},
+			{Name: "Lime Wheel", Units: 1, UoM: "discretion"},
+		},
+		Keywords: cocktailsapi.CocktailKeywordsModel{KeywordsTechnique: []string{"Shaken"}},
+	}
+}
+
+func Test_estimate_applies_dilution_for_technique(t *testing.T) {
+	t.Parallel()
+
+	estimate, err := strength.EstimateCocktail(peguClub(), strength.Options{})
+	require.NoError(t, err)
+
+	require.Equal(t, strength.Shaken, estimate.Technique)
+	require.Equal(t, 0.85, estimate.DilutionRate)
+	require.InDelta(t, 104, estimate.UndilutedVolumeMl, 1)
+	require.InDelta(t, 29.5, estimate.UndilutedABV, 0.1)
+	require.InDelta(t, 194, estimate.FinalVolumeMl, 1)
+	require.InDelta(t, 15.9, estimate.ABV, 0.1)
+	require.InDelta(t, 1.7, estimate.StandardDrinks, 0.05)
+	require.Len(t, estimate.Ingredients, 4)
+	require.Equal(t, []string{"Lime Wheel"}, estimate.Unmeasured)
+}
+
+func Test_estimate_matches_a_reference_shaken_daiquiri(t *testing.T) {
+	t.Parallel()
+
+	// Liquid Intelligence's classic daiquiri of 2 oz rum, 3/4 oz lime and 3/4 oz simple syrup finishes at roughly
+	// 15% ABV once shaken.
+	daiquiri := &cocktailsapi.CocktailModel{
+		Id:     "daiquiri",
+		Serves: 1,
+		Ingredients: []cocktailsapi.IngredientModel{
+			{Name: "White Rum", Units: 2, UoM: "ounces", Types: []string{"Spirits", "Rum"}},
+			{Name: "Lime Juice", Units: 0.75, UoM: "ounces"},
+			{Name: "Simple Syrup", Units: 0.75, UoM: "ounces"},
+		},
+		Keywords: cocktailsapi.CocktailKeywordsModel{KeywordsTechnique: []string{"Shaken"}},
+	}
+
+	estimate, err := strength.EstimateCocktail(daiquiri, strength.Options{})
+	require.NoError(t, err)
+
+	require.InDelta(t, 22.9, estimate.UndilutedABV, 0.1)
+	require.InDelta(t, 15, estimate.ABV, 1.5)
+}
+
+func Test_dilution_depends_on_technique_and_starting_abv(t *testing.T) {
+	t.Parallel()
+
+	require.InDelta(t, 0.50, strength.DilutionRate(strength.Shaken, 15), 0.01)
+	require.InDelta(t, 0.87, strength.DilutionRate(strength.Shaken, 30), 0.01)
+	require.InDelta(t, 0.30, strength.DilutionRate(strength.Stirred, 15), 0.01)
+	require.InDelta(t, 0.41, strength.DilutionRate(strength.Stirred, 30), 0.01)
+	require.Equal(t, strength.DilutionRate(strength.Stirred, 30), strength.DilutionRate(strength.Thrown, 30))
+	require.Equal(t, strength.DilutionRate(strength.Shaken, 30), strength.DilutionRate(strength.Swizzled, 30))
+	require.Equal(t, 0.25, strength.DilutionRate(strength.Built, 30))
+	require.Zero(t, strength.DilutionRate(strength.Unknown, 30))
+}
+
+func Test_estimate_uses_technique_override_and_standard_drink_size(t *testing.T) {
+	t.Parallel()
+
+	estimate, err := strength.EstimateCocktail(peguClub(), strength.Options{Technique: "stir", StandardDrinkGrams: 8})
+	require.NoError(t, err)
+
+	require.Equal(t, strength.Stirred, estimate.Technique)
+	require.InDelta(t, 21.0, estimate.ABV, 0.1)
+	require.InDelta(t, 3.0, estimate.StandardDrinks, 0.05)
+
+	_, err = strength.EstimateCocktail(peguClub(), strength.Options{Technique: "muddled"})
+	require.ErrorContains(t, err, "unknown technique \"muddled\"")
+}
+
+func Test_estimate_without_technique_applies_no_dilution(t *testing.T) {
+	t.Parallel()
+
+	cocktail := peguClub()
+	cocktail.Keywords.KeywordsTechnique = nil
+
+	estimate, err := strength.EstimateCocktail(cocktail, strength.Options{})
+	require.NoError(t, err)
+
+	require.Equal(t, strength.Unknown, estimate.Technique)
+	require.Equal(t, estimate.UndilutedABV, estimate.ABV)
+	require.NotEmpty(t, estimate.Notes)
+}
+
+func Test_estimate_divides_batches_by_servings(t *testing.T) {
+	t.Parallel()
+
+	single, err := strength.EstimateCocktail(peguClub(), strength.Options{})
+	require.NoError(t, err)
+
+	batch := peguClub()
+	batch.Serves = 4
+	for i := range batch.Ingredients {
+		batch.Ingredients[i].Units *= 4
+	}
+
+	estimate, err := strength.EstimateCocktail(batch, strength.Options{})
+	require.NoError(t, err)
+
+	require.Equal(t, single.ABV, estimate.ABV)
+	require.InDelta(t, single.StandardDrinks, estimate.StandardDrinks, 0.05)
+}
+
+func Test_ingredient_abv_prefers_id_then_types_then_name(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ingredient cocktailsapi.IngredientModel
+		abv        float64
+		matchedOn  string
+	}{
+		{cocktailsapi.IngredientModel{Id: "green-chartreuse", Name: "Chartreuse"}, 55, "green chartreuse"},
+		{cocktailsapi.IngredientModel{Name: "Plymouth", Types: []string{"Spirits", "Gin", "Navy Strength Gin"}}, 57, "navy strength gin"},
+		{cocktailsapi.IngredientModel{Name: "Yellow Chartreuse"}, 40, "yellow chartreuse"},
+		{cocktailsapi.IngredientModel{Name: "Peychaud's Bitters"}, 35, "peychauds bitters"},
+		{cocktailsapi.IngredientModel{Name: "Ginger Beer"}, 0, "ginger beer"},
+		{cocktailsapi.IngredientModel{Name: "Fresh Lime Juice"}, 0, ""},
+	}
+
+	for _, test := range tests {
+		abv, matchedOn := strength.IngredientABV(test.ingredient)
+		require.Equal(t, test.abv, abv, test.ingredient.Name)
+		require.Equal(t, test.matchedOn, matchedOn, test.ingredient.Name)
+	}
+}
+
+func Test_estimate_returns_error_without_measured_ingredients(t *testing.T) {
+	t.Parallel()
+
+	_, err := strength.EstimateCocktail(&cocktailsapi.CocktailModel{
+		Id:          "mystery",
+		Ingredients: []cocktailsapi.IngredientModel{{Name: "Champagne", UoM: "topoff"}},
+	}, strength.Options{})
+	require.ErrorContains(t, err, "no measured ingredients")
+}
@@ -0,0 +1,82 @@
+//coverage:ignore file
+package testutils
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/middleware"
+)
+
+// MemoryTokenStore is an in-memory auth.TokenStore for tests that need an authenticated session.
+type MemoryTokenStore struct {
+	mu     sync.Mutex
+	tokens map[string]*auth.TokenResponse
+}
+
+// SaveToken stores the tokens for the session.
+func (store *MemoryTokenStore) SaveToken(_ context.Context, sessionID string, tokens *auth.TokenResponse) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if store.tokens == nil {
+		store.tokens = map[string]*auth.TokenResponse{}
+	}
+	store.tokens[sessionID] = tokens
+
+	return nil
+}
+
+// GetToken returns the tokens of the session, or nil when the session has none.
+func (store *MemoryTokenStore) GetToken(_ context.Context, sessionID string) (*auth.TokenResponse, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	return store.tokens[sessionID], nil
+}
+
+// ClearTokens removes the tokens of the session.
+func (store *MemoryTokenStore) ClearTokens(_ context.Context, sessionID string) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	delete(store.tokens, sessionID)
+
+	return nil
+}
+
+// Authenticate returns an OAuth flow manager in which the session of ctx, as created by Setup, is authenticated
+// with an access token that does not expire during the test.
+func Authenticate(t *testing.T, ctx context.Context) *auth.OAuthFlowManager {
+	t.Helper()
+
+	sessionID, _ := ctx.Value(middleware.McpSessionIDKey).(string)
+	if sessionID == "" {
+		t.Fatalf("The context has no MCP session id")
+	}
+
+	store := &MemoryTokenStore{}
+	_ = store.SaveToken(ctx, sessionID, &auth.TokenResponse{
+		AccessToken: "test-access-token",
+		TokenType:   "Bearer",
+		ExpiresAt:   time.Now().Add(time.Hour),
+	})
+
+	return auth.NewOAuthFlowManagerWithStore(store)
+}
+
+// AccountsClient returns an accounts API client configured to talk to the test server created by Setup.
+func AccountsClient(t *testing.T, serverURL string) *accountsapi.Client {
+	t.Helper()
+
+	client, err := accountsapi.NewClient(serverURL + baseURLPath + "/")
+	if err != nil {
+		t.Fatalf("Failed to create accounts API client: %v", err)
+	}
+
+	return client
+}
@@ -0,0 +1,90 @@
+//coverage:ignore file
+package testutils
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"cezzis.com/cezzis-mcp-server/internal/repos"
+)
+
+// MemoryBarInventory is an in-memory repos.BarInventoryRepository for tests of the bar inventory tools.
+type MemoryBarInventory struct {
+	mu   sync.Mutex
+	bars map[string][]repos.BarIngredient
+}
+
+// barKey returns the key of a user's bar.
+func barKey(userID string, barID string) string {
+	return userID + "|" + barID
+}
+
+// AddIngredients upserts the ingredients into the user's bar, keeping the original date each ingredient was added.
+func (inventory *MemoryBarInventory) AddIngredients(_ context.Context, userID string, barID string, ingredients []repos.BarIngredient) error {
+	inventory.mu.Lock()
+	defer inventory.mu.Unlock()
+
+	if inventory.bars == nil {
+		inventory.bars = map[string][]repos.BarIngredient{}
+	}
+
+	key := barKey(userID, barID)
+	for _, ingredient := range ingredients {
+		index := slices.IndexFunc(inventory.bars[key], func(stocked repos.BarIngredient) bool {
+			return stocked.IngredientID == ingredient.IngredientID
+		})
+		if index >= 0 {
+			ingredient.AddedOn = inventory.bars[key][index].AddedOn
+			inventory.bars[key][index] = ingredient
+			continue
+		}
+		if ingredient.AddedOn.IsZero() {
+			ingredient.AddedOn = time.Now()
+		}
+		inventory.bars[key] = append(inventory.bars[key], ingredient)
+	}
+
+	return nil
+}
+
+// RemoveIngredients removes the ingredients from the user's bar and returns the number that were removed.
+func (inventory *MemoryBarInventory) RemoveIngredients(_ context.Context, userID string, barID string, ingredientIDs []string) (int64, error) {
+	inventory.mu.Lock()
+	defer inventory.mu.Unlock()
+
+	key := barKey(userID, barID)
+	before := len(inventory.bars[key])
+	inventory.bars[key] = slices.DeleteFunc(inventory.bars[key], func(stocked repos.BarIngredient) bool {
+		return slices.Contains(ingredientIDs, stocked.IngredientID)
+	})
+
+	return int64(before - len(inventory.bars[key])), nil
+}
+
+// RemoveBar removes every ingredient from the user's bar and returns the number that were removed.
+func (inventory *MemoryBarInventory) RemoveBar(_ context.Context, userID string, barID string) (int64, error) {
+	inventory.mu.Lock()
+	defer inventory.mu.Unlock()
+
+	key := barKey(userID, barID)
+	removed := len(inventory.bars[key])
+	delete(inventory.bars, key)
+
+	return int64(removed), nil
+}
+
+// ListIngredients returns the ingredients in the user's bar ordered by name.
+func (inventory *MemoryBarInventory) ListIngredients(_ context.Context, userID string, barID string) ([]repos.BarIngredient, error) {
+	inventory.mu.Lock()
+	defer inventory.mu.Unlock()
+
+	ingredients := slices.Clone(inventory.bars[barKey(userID, barID)])
+	slices.SortFunc(ingredients, func(a, b repos.BarIngredient) int {
+		return strings.Compare(a.Name+"|"+a.IngredientID, b.Name+"|"+b.IngredientID)
+	})
+
+	return append([]repos.BarIngredient{}, ingredients...), nil
+}
@@ -0,0 +1,217 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/repos"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+const (
+	// maxBarIngredientsPerRequest limits the number of ingredients that can be added or removed in a single request.
+	maxBarIngredientsPerRequest = 25
+
+	// maxConcurrentIngredientFetches limits the number of simultaneous cocktails API calls made when resolving ingredients.
+	maxConcurrentIngredientFetches = 5
+
+	// maxIngredientParentLookups limits the number of catalog ingredients looked up in a single request to find
+	// their parents.  Ingredients beyond the limit are matched without substitutes.
+	maxIngredientParentLookups = 40
+
+	// ingredientParentTTL is how long the catalog parent of an ingredient is cached.
+	ingredientParentTTL = time.Hour
+)
+
+// BarInventory is the list of ingredients stocked in one of the authenticated user's bars.
+type BarInventory struct {
+	BarID       string                `json:"barId"`
+	BarName     string                `json:"barName"`
+	Ingredients []repos.BarIngredient `json:"ingredients"`
+}
+
+// barIngredientIDsFromRequest extracts the required ingredientIds argument, trimming and de-duplicating
+// the ids and ensuring there is at least one and no more than maxBarIngredientsPerRequest.
+func barIngredientIDsFromRequest(request mcp.CallToolRequest) ([]string, error) {
+	values, err := request.RequireStringSlice("ingredientIds")
+	if err != nil {
+		return nil, err
+	}
+
+	ingredientIDs := dedupeStrings(compactStrings(values))
+	if len(ingredientIDs) == 0 {
+		return nil, errors.New("argument \"ingredientIds\" must contain at least one ingredient id")
+	}
+
+	if len(ingredientIDs) > maxBarIngredientsPerRequest {
+		return nil, fmt.Errorf("argument \"ingredientIds\" cannot contain more than %d ingredient ids", maxBarIngredientsPerRequest)
+	}
+
+	return ingredientIDs, nil
+}
+
+// fetchOwnedBar retrieves one of the authenticated user's bars from the accounts API.  The accounts API only
+// returns bars owned by the user, so this also verifies the bar belongs to them.
+func fetchOwnedBar(ctx context.Context, authManager *auth.OAuthFlowManager, client *accountsapi.Client, barID string) (*accountsapi.BarModel, error) {
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := client.GetV1AccountsOwnedProfileBarById(callCtx, barID, &accountsapi.GetV1AccountsOwnedProfileBarByIdParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, accountsapi.RequestEditor(authManager))
+
+	barRs, err := decodeResponse[accountsapi.BarRs](ctx, rs, callErr, "getting bar "+barID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &barRs.Item, nil
+}
+
+// resolveBarOwner verifies the bar belongs to the authenticated user and returns the user's subject id, which
+// keys the bar's inventory, along with the bar itself.
+func resolveBarOwner(ctx context.Context, authManager *auth.OAuthFlowManager, client *accountsapi.Client, barID string) (string, *accountsapi.BarModel, error) {
+	bar, err := fetchOwnedBar(ctx, authManager, client, barID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	profile, err := fetchOwnedProfile(ctx, authManager, client)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if profile.SubjectId == "" {
+		return "", nil, errors.New("the account profile does not have a subject id")
+	}
+
+	return profile.SubjectId, bar, nil
+}
+
+// fetchIngredient retrieves a single ingredient from the cocktails API ingredient catalog.
+func fetchIngredient(ctx context.Context, client *cocktailsapi.Client, ingredientID string) (*cocktailsapi.InventoryIngredientModel, error) {
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := client.GetIngredient(callCtx, ingredientID, &cocktailsapi.GetIngredientParams{
+		XKey: &config.GetAppSettings().CocktailsAPISubscriptionKey,
+	}, cocktailsapi.RequestEditor())
+
+	ingredientRs, err := decodeResponse[cocktailsapi.IngredientRs](ctx, rs, callErr, "getting ingredient "+ingredientID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ingredientRs.Item, nil
+}
+
+// fetchIngredients retrieves the catalog ingredients for the supplied ids using a bounded number of concurrent
+// requests.  The ingredients are returned keyed by the requested id.  Ids that could not be retrieved are
+// returned separately so callers can report them rather than failing the whole request.
+func fetchIngredients(ctx context.Context, client *cocktailsapi.Client, ingredientIDs []string) (map[string]cocktailsapi.InventoryIngredientModel, []string) {
+	results := make([]*cocktailsapi.InventoryIngredientModel, len(ingredientIDs))
+	semaphore := make(chan struct{}, maxConcurrentIngredientFetches)
+
+	var wg sync.WaitGroup
+	for i, ingredientID := range ingredientIDs {
+		wg.Add(1)
+		go func(i int, ingredientID string) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			ingredient, err := fetchIngredient(ctx, client, ingredientID)
+			if err != nil {
+				telemetry.Logger.Warn().Ctx(ctx).Err(err).Msg("MCP Warning: failed to fetch ingredient: " + ingredientID)
+				return
+			}
+
+			results[i] = ingredient
+		}(i, ingredientID)
+	}
+
+	wg.Wait()
+
+	ingredients := make(map[string]cocktailsapi.InventoryIngredientModel, len(ingredientIDs))
+	failed := []string{}
+	for i, ingredient := range results {
+		if ingredient == nil {
+			failed = append(failed, ingredientIDs[i])
+			continue
+		}
+		ingredients[ingredientIDs[i]] = *ingredient
+	}
+
+	return ingredients, failed
+}
+
+// ingredientParentCache caches the catalog parents of ingredients, which rarely change, so recipes can be matched
+// against a bar's substitutes without looking up every ingredient on every request.  It is safe for concurrent use.
+type ingredientParentCache struct {
+	mu      sync.Mutex
+	entries map[string]cachedIngredientParent
+}
+
+// cachedIngredientParent is the catalog parent of an ingredient, which is empty for ingredients without one.
+type cachedIngredientParent struct {
+	parentID string
+	expires  time.Time
+}
+
+// newIngredientParentCache creates an empty ingredient parent cache.
+func newIngredientParentCache() *ingredientParentCache {
+	return &ingredientParentCache{entries: map[string]cachedIngredientParent{}}
+}
+
+// resolve returns the catalog parent of each ingredient id keyed by the id.  Cached parents are used when possible
+// and at most maxIngredientParentLookups of the remaining ids are looked up in the ingredient catalog, in the
+// order supplied.  Ids that are not resolved are left out of the result.
+func (cache *ingredientParentCache) resolve(ctx context.Context, client *cocktailsapi.Client, ingredientIDs []string) map[string]string {
+	parents := make(map[string]string, len(ingredientIDs))
+	uncached := []string{}
+	now := time.Now()
+
+	cache.mu.Lock()
+	for _, ingredientID := range dedupeStrings(ingredientIDs) {
+		if entry, ok := cache.entries[ingredientID]; ok && now.Before(entry.expires) {
+			parents[ingredientID] = entry.parentID
+			continue
+		}
+		uncached = append(uncached, ingredientID)
+	}
+	cache.mu.Unlock()
+
+	if len(uncached) == 0 || client == nil {
+		return parents
+	}
+
+	if len(uncached) > maxIngredientParentLookups {
+		telemetry.Logger.Debug().Ctx(ctx).Int("skipped", len(uncached)-maxIngredientParentLookups).Msg("MCP limiting ingredient parent lookups")
+		uncached = uncached[:maxIngredientParentLookups]
+	}
+
+	catalog, _ := fetchIngredients(ctx, client, uncached)
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	for ingredientID, ingredient := range catalog {
+		parents[ingredientID] = ingredient.ParentId
+		cache.entries[ingredientID] = cachedIngredientParent{
+			parentID: ingredient.ParentId,
+			expires:  now.Add(ingredientParentTTL),
+		}
+	}
+
+	return parents
+}
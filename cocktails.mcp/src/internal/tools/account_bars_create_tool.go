@@ -0,0 +1,84 @@
+package tools
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var createBarDescription = `This tool creates a new bar in your Cezzis.com account, such as a "Home Bar" or "Cabin", with an optional description.
+Existing bars can be found with the 'list_bars' tool.  The created bar, including its new bar ID, is returned.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// CreateBarTool creates a bar for the authenticated user
+var CreateBarTool = mcp.NewTool(
+	"create_bar",
+	mcp.WithDescription(createBarDescription),
+	mcp.WithString("name",
+		mcp.Required(),
+		mcp.Description("The name of the new bar, for example 'Home Bar'."),
+	),
+	mcp.WithString("description",
+		mcp.Description("An optional description of the bar."),
+	),
+)
+
+// CreateBarToolHandler handles bar creation requests
+type CreateBarToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewCreateBarToolHandler creates a new bar creation handler
+func NewCreateBarToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *CreateBarToolHandler {
+	return &CreateBarToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles bar creation requests
+func (handler *CreateBarToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if result, err := requireAuthenticated(ctx, handler.authManager, "create bars"); result != nil {
+		return result, err
+	}
+
+	name, err := requireNonEmptyString(request, "name")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	body := accountsapi.PostV1AccountsOwnedProfileBarsJSONRequestBody{
+		Name: name,
+	}
+
+	if description := strings.TrimSpace(request.GetString("description", "")); description != "" {
+		body.Description = &description
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP creating bar: " + name)
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.PostV1AccountsOwnedProfileBars(callCtx, &accountsapi.PostV1AccountsOwnedProfileBarsParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, body, accountsapi.RequestEditor(handler.authManager))
+
+	bodyBytes, err := readResponse(ctx, rs, callErr, "creating bar "+name)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(bodyBytes)), nil
+}
@@ -0,0 +1,67 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func Test_createbar_toolhandler_posts_the_bar(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	var received accountsapi.CreateBarRq
+	mux.HandleFunc("POST /api/v1/accounts/owned/profile/bars", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"item":{"id":"bar-2","name":"Cabin"}}`)
+	})
+
+	handler := tools.NewCreateBarToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	result, err := handler.Handle(ctx, mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      "create_bar",
+			Arguments: map[string]interface{}{"name": " Cabin ", "description": " "},
+		},
+	})
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Equal(t, "Cabin", received.Name)
+	require.Nil(t, received.Description)
+	require.Equal(t, `{"item":{"id":"bar-2","name":"Cabin"}}`, result.Content[0].(mcp.TextContent).Text)
+}
+
+func Test_createbar_toolhandler_requires_a_name(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, _, ctx, serverURL := testutils.Setup(t)
+
+	handler := tools.NewCreateBarToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	result, err := handler.Handle(ctx, mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      "create_bar",
+			Arguments: map[string]interface{}{"name": " "},
+		},
+	})
+
+	// assert
+	require.Error(t, err)
+	require.True(t, result.IsError)
+}
@@ -0,0 +1,89 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/repos"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var deleteBarDescription = `This tool permanently deletes a bar, along with the ingredients stocked in it, from your Cezzis.com account.  Always
+confirm with the user before deleting a bar.
+Bar IDs can be found from the 'list_bars' tool.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// DeleteBarTool deletes one of the authenticated user's bars
+var DeleteBarTool = mcp.NewTool(
+	"delete_bar",
+	mcp.WithDescription(deleteBarDescription),
+	mcp.WithDestructiveHintAnnotation(true),
+	mcp.WithString("barId",
+		mcp.Required(),
+		mcp.Description("The ID of the bar to delete.  This can be found from the list_bars tool results by the 'id' field."),
+	),
+)
+
+// DeleteBarToolHandler handles bar deletion requests
+type DeleteBarToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+	repository  repos.BarInventoryRepository
+}
+
+// NewDeleteBarToolHandler creates a new bar deletion handler.  The repository holds the ingredients stocked in
+// each bar, which are removed along with the bar.
+func NewDeleteBarToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client, repository repos.BarInventoryRepository) *DeleteBarToolHandler {
+	return &DeleteBarToolHandler{
+		authManager: authManager,
+		client:      client,
+		repository:  repository,
+	}
+}
+
+// Handle handles bar deletion requests
+func (handler *DeleteBarToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if result, err := requireAuthenticated(ctx, handler.authManager, "delete bars"); result != nil {
+		return result, err
+	}
+
+	barID, err := requireNonEmptyString(request, "barId")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP deleting bar: " + barID)
+
+	// The bar's inventory is keyed by the owner's subject id, which must be looked up before the bar is gone.
+	profile, err := fetchOwnedProfile(ctx, handler.authManager, handler.client)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.DeleteV1AccountsOwnedProfileBarById(callCtx, barID, &accountsapi.DeleteV1AccountsOwnedProfileBarByIdParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, accountsapi.RequestEditor(handler.authManager))
+
+	if _, err := readResponse(ctx, rs, callErr, "deleting bar "+barID); err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	// The bar itself is gone, so failing to remove its inventory is logged rather than failing the request.
+	if _, err := handler.repository.RemoveBar(ctx, profile.SubjectId, barID); err != nil {
+		telemetry.Logger.Warn().Ctx(ctx).Err(err).Msg("MCP Warning: failed to remove the ingredients of deleted bar: " + barID)
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Successfully deleted bar '%s'.", barID)), nil
+}
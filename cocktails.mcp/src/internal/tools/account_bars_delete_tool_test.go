@@ -0,0 +1,54 @@
+package tools_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/repos"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func Test_deletebar_toolhandler_removes_the_bars_ingredients(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	registerOwnedBar(t, mux, "bar-1", "Home Bar")
+
+	deleted := false
+	mux.HandleFunc("DELETE /api/v1/accounts/owned/profile/bars/bar-1", func(w http.ResponseWriter, r *http.Request) {
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	inventory := &testutils.MemoryBarInventory{}
+	require.NoError(t, inventory.AddIngredients(ctx, "user-1", "bar-1", []repos.BarIngredient{{IngredientID: "campari", Name: "Campari"}}))
+	require.NoError(t, inventory.AddIngredients(ctx, "user-1", "bar-2", []repos.BarIngredient{{IngredientID: "campari", Name: "Campari"}}))
+
+	handler := tools.NewDeleteBarToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL), inventory)
+
+	// act
+	result, err := handler.Handle(ctx, mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      "delete_bar",
+			Arguments: map[string]interface{}{"barId": "bar-1"},
+		},
+	})
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.True(t, deleted)
+	require.Equal(t, "Successfully deleted bar 'bar-1'.", result.Content[0].(mcp.TextContent).Text)
+
+	remaining, _ := inventory.ListIngredients(ctx, "user-1", "bar-1")
+	require.Empty(t, remaining)
+
+	other, _ := inventory.ListIngredients(ctx, "user-1", "bar-2")
+	require.Len(t, other, 1)
+}
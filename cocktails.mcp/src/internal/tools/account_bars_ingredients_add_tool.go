@@ -0,0 +1,131 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/repos"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var addBarIngredientsDescription = fmt.Sprintf(`This tool stocks one of the bars in your Cezzis.com account with ingredients, recording the bottles, mixers and garnishes
+you have on hand so the 'what_can_i_make' tool can suggest cocktails.  Ingredients are identified by their ingredient catalog id, which can be
+found with the 'list_ingredients' and 'get_ingredient' tools.  Up to %d ingredients can be added at once, and adding an ingredient that is
+already stocked has no effect.  Bar IDs can be found from the 'list_bars' tool.  The bar's full inventory is returned.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`, maxBarIngredientsPerRequest)
+
+// AddBarIngredientsTool adds ingredients to one of the authenticated user's bars
+var AddBarIngredientsTool = mcp.NewTool(
+	"add_bar_ingredients",
+	mcp.WithDescription(addBarIngredientsDescription),
+	mcp.WithString("barId",
+		mcp.Required(),
+		mcp.Description("The ID of the bar to stock.  This can be found from the list_bars tool results by the 'id' field."),
+	),
+	mcp.WithArray("ingredientIds",
+		mcp.Required(),
+		mcp.Description("The ingredient catalog ids of the ingredients to add, as returned by the list_ingredients tool."),
+		mcp.WithStringItems(),
+		mcp.MinItems(1),
+		mcp.MaxItems(maxBarIngredientsPerRequest),
+	),
+)
+
+// AddBarIngredientsResult is the outcome of adding ingredients to a bar.
+type AddBarIngredientsResult struct {
+	BarInventory
+	NotFound []string `json:"notFound,omitempty"`
+}
+
+// AddBarIngredientsToolHandler handles requests to add ingredients to a bar
+type AddBarIngredientsToolHandler struct {
+	authManager     *auth.OAuthFlowManager
+	accountsClient  *accountsapi.Client
+	cocktailsClient *cocktailsapi.Client
+	repository      repos.BarInventoryRepository
+}
+
+// NewAddBarIngredientsToolHandler creates a new bar ingredient add handler
+func NewAddBarIngredientsToolHandler(authManager *auth.OAuthFlowManager, accountsClient *accountsapi.Client, cocktailsClient *cocktailsapi.Client, repository repos.BarInventoryRepository) *AddBarIngredientsToolHandler {
+	return &AddBarIngredientsToolHandler{
+		authManager:     authManager,
+		accountsClient:  accountsClient,
+		cocktailsClient: cocktailsClient,
+		repository:      repository,
+	}
+}
+
+// Handle handles requests to add ingredients to a bar
+func (handler *AddBarIngredientsToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	barID, err := requireNonEmptyString(request, "barId")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	ingredientIDs, err := barIngredientIDsFromRequest(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	if result, err := requireAuthenticated(ctx, handler.authManager, "stock your bars"); result != nil {
+		return result, err
+	}
+
+	userID, bar, err := resolveBarOwner(ctx, handler.authManager, handler.accountsClient, barID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	// The catalog supplies the display name and parent of each ingredient, which what_can_i_make uses for substitutions
+	catalog, notFound := fetchIngredients(ctx, handler.cocktailsClient, ingredientIDs)
+	if len(catalog) == 0 {
+		err := fmt.Errorf("none of the ingredients were found in the ingredient catalog: %v", notFound)
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	ingredients := make([]repos.BarIngredient, 0, len(catalog))
+	for _, ingredientID := range ingredientIDs {
+		if ingredient, ok := catalog[ingredientID]; ok {
+			ingredients = append(ingredients, repos.BarIngredient{
+				IngredientID: ingredient.Id,
+				Name:         ingredient.Name,
+				ParentID:     ingredient.ParentId,
+			})
+		}
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Int("count", len(ingredients)).Msg("MCP adding bar ingredients: " + barID)
+
+	if err := handler.repository.AddIngredients(ctx, userID, barID, ingredients); err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	stocked, err := handler.repository.ListIngredients(ctx, userID, barID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	jsonBytes, err := json.Marshal(AddBarIngredientsResult{
+		BarInventory: BarInventory{
+			BarID:       bar.Id,
+			BarName:     bar.Name,
+			Ingredients: stocked,
+		},
+		NotFound: notFound,
+	})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
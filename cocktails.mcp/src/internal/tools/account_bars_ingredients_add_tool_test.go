@@ -0,0 +1,122 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/repos"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+// registerOwnedBar serves the profile of the authenticated user "user-1" and their bar from the accounts API.
+func registerOwnedBar(t *testing.T, mux *http.ServeMux, barID string, name string) {
+	mux.HandleFunc("/api/v1/accounts/owned/profile", func(w http.ResponseWriter, r *http.Request) {
+		testutils.TestMethod(t, r, "GET")
+		fmt.Fprint(w, `{"subjectId":"user-1"}`)
+	})
+	mux.HandleFunc("/api/v1/accounts/owned/profile/bars/"+barID, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"item":{"id":%q,"name":%q,"description":null,"createdOn":"2026-01-02T03:04:05Z"}}`, barID, name)
+	})
+}
+
+// barIngredientsRequest creates a request for one of the bar ingredient tools.
+func barIngredientsRequest(name string, barID string, ingredientIDs ...interface{}) mcp.CallToolRequest {
+	return mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      name,
+			Arguments: map[string]interface{}{"barId": barID, "ingredientIds": ingredientIDs},
+		},
+	}
+}
+
+func Test_addbaringredients_toolhandler_validates_ingredient_ids(t *testing.T) {
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, _, ctx, _ := testutils.Setup(t)
+
+	tests := []struct {
+		name           string
+		ingredientIDs  interface{}
+		expectedErrMsg string
+	}{
+		{
+			name:           "blank ids",
+			ingredientIDs:  []interface{}{" ", ""},
+			expectedErrMsg: "argument \"ingredientIds\" must contain at least one ingredient id",
+		},
+		{
+			name:           "too many ids",
+			ingredientIDs:  manyIngredientIDs(26),
+			expectedErrMsg: "argument \"ingredientIds\" cannot contain more than 25 ingredient ids",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Name:      "add_bar_ingredients",
+					Arguments: map[string]interface{}{"barId": "bar-1", "ingredientIds": test.ingredientIDs},
+				},
+			}
+
+			handler := tools.NewAddBarIngredientsToolHandler(nil, nil, nil, nil)
+
+			// act
+			result, err := handler.Handle(ctx, request)
+
+			// assert
+			testutils.AssertError(t, result, err, test.expectedErrMsg)
+		})
+	}
+}
+
+func manyIngredientIDs(count int) []interface{} {
+	ids := make([]interface{}, 0, count)
+	for i := range count {
+		ids = append(ids, "ingredient-"+string(rune('a'+i)))
+	}
+	return ids
+}
+
+func Test_addbaringredients_toolhandler_stocks_catalog_ingredients(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	cocktailsClient, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	registerOwnedBar(t, mux, "bar-1", "Home Bar")
+	mux.HandleFunc("/api/v1/cocktails/ingredients/london-dry-gin", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"item":{"id":"london-dry-gin","name":"London Dry Gin","parentId":"gin"}}`)
+	})
+	mux.HandleFunc("/api/v1/cocktails/ingredients/unknown", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	})
+
+	inventory := &testutils.MemoryBarInventory{}
+	require.NoError(t, inventory.AddIngredients(ctx, "user-1", "bar-1", []repos.BarIngredient{{IngredientID: "campari", Name: "Campari"}}))
+
+	handler := tools.NewAddBarIngredientsToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL), cocktailsClient, inventory)
+
+	// act
+	result, err := handler.Handle(ctx, barIngredientsRequest("add_bar_ingredients", "bar-1", "london-dry-gin", "unknown"))
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var added tools.AddBarIngredientsResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &added))
+	require.Equal(t, "Home Bar", added.BarName)
+	require.Equal(t, []string{"unknown"}, added.NotFound)
+	require.Len(t, added.Ingredients, 2)
+	require.Equal(t, "Campari", added.Ingredients[0].Name)
+	require.Equal(t, "London Dry Gin", added.Ingredients[1].Name)
+	require.Equal(t, "gin", added.Ingredients[1].ParentID)
+}
@@ -0,0 +1,82 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/repos"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var listBarIngredientsDescription = `This tool lists the ingredients stocked in one of the bars in your Cezzis.com account.  Each ingredient is returned
+with its ingredient catalog id, name, parent ingredient id and the date it was added.  Bar IDs can be found from the 'list_bars' tool.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// ListBarIngredientsTool lists the ingredients stocked in one of the authenticated user's bars
+var ListBarIngredientsTool = mcp.NewTool(
+	"list_bar_ingredients",
+	mcp.WithDescription(listBarIngredientsDescription),
+	mcp.WithString("barId",
+		mcp.Required(),
+		mcp.Description("The ID of the bar to list.  This can be found from the list_bars tool results by the 'id' field."),
+	),
+)
+
+// ListBarIngredientsToolHandler handles bar ingredient listing requests
+type ListBarIngredientsToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+	repository  repos.BarInventoryRepository
+}
+
+// NewListBarIngredientsToolHandler creates a new bar ingredient listing handler
+func NewListBarIngredientsToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client, repository repos.BarInventoryRepository) *ListBarIngredientsToolHandler {
+	return &ListBarIngredientsToolHandler{
+		authManager: authManager,
+		client:      client,
+		repository:  repository,
+	}
+}
+
+// Handle handles bar ingredient listing requests
+func (handler *ListBarIngredientsToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	barID, err := requireNonEmptyString(request, "barId")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	if result, err := requireAuthenticated(ctx, handler.authManager, "list your bar ingredients"); result != nil {
+		return result, err
+	}
+
+	userID, bar, err := resolveBarOwner(ctx, handler.authManager, handler.client, barID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP listing bar ingredients: " + barID)
+
+	stocked, err := handler.repository.ListIngredients(ctx, userID, barID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	jsonBytes, err := json.Marshal(BarInventory{
+		BarID:       bar.Id,
+		BarName:     bar.Name,
+		Ingredients: stocked,
+	})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
@@ -0,0 +1,111 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/repos"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var removeBarIngredientsDescription = fmt.Sprintf(`This tool removes ingredients from one of the bars in your Cezzis.com account, for example when a bottle runs out.
+Ingredients are identified by their ingredient catalog id, which can be found from the 'list_bar_ingredients' tool.  Up to %d ingredients can be
+removed at once.  Bar IDs can be found from the 'list_bars' tool.  The bar's remaining inventory is returned.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`, maxBarIngredientsPerRequest)
+
+// RemoveBarIngredientsTool removes ingredients from one of the authenticated user's bars
+var RemoveBarIngredientsTool = mcp.NewTool(
+	"remove_bar_ingredients",
+	mcp.WithDescription(removeBarIngredientsDescription),
+	mcp.WithDestructiveHintAnnotation(true),
+	mcp.WithString("barId",
+		mcp.Required(),
+		mcp.Description("The ID of the bar to remove the ingredients from.  This can be found from the list_bars tool results by the 'id' field."),
+	),
+	mcp.WithArray("ingredientIds",
+		mcp.Required(),
+		mcp.Description("The ingredient catalog ids of the ingredients to remove, as returned by the list_bar_ingredients tool."),
+		mcp.WithStringItems(),
+		mcp.MinItems(1),
+		mcp.MaxItems(maxBarIngredientsPerRequest),
+	),
+)
+
+// RemoveBarIngredientsResult is the outcome of removing ingredients from a bar.
+type RemoveBarIngredientsResult struct {
+	BarInventory
+	Removed int64 `json:"removed"`
+}
+
+// RemoveBarIngredientsToolHandler handles requests to remove ingredients from a bar
+type RemoveBarIngredientsToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+	repository  repos.BarInventoryRepository
+}
+
+// NewRemoveBarIngredientsToolHandler creates a new bar ingredient removal handler
+func NewRemoveBarIngredientsToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client, repository repos.BarInventoryRepository) *RemoveBarIngredientsToolHandler {
+	return &RemoveBarIngredientsToolHandler{
+		authManager: authManager,
+		client:      client,
+		repository:  repository,
+	}
+}
+
+// Handle handles requests to remove ingredients from a bar
+func (handler *RemoveBarIngredientsToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	barID, err := requireNonEmptyString(request, "barId")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	ingredientIDs, err := barIngredientIDsFromRequest(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	if result, err := requireAuthenticated(ctx, handler.authManager, "stock your bars"); result != nil {
+		return result, err
+	}
+
+	userID, bar, err := resolveBarOwner(ctx, handler.authManager, handler.client, barID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Int("count", len(ingredientIDs)).Msg("MCP removing bar ingredients: " + barID)
+
+	removed, err := handler.repository.RemoveIngredients(ctx, userID, barID, ingredientIDs)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	stocked, err := handler.repository.ListIngredients(ctx, userID, barID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	jsonBytes, err := json.Marshal(RemoveBarIngredientsResult{
+		BarInventory: BarInventory{
+			BarID:       bar.Id,
+			BarName:     bar.Name,
+			Ingredients: stocked,
+		},
+		Removed: removed,
+	})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
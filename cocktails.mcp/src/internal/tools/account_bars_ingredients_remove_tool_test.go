@@ -0,0 +1,43 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/repos"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func Test_removebaringredients_toolhandler_removes_stocked_ingredients(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	registerOwnedBar(t, mux, "bar-1", "Home Bar")
+
+	inventory := &testutils.MemoryBarInventory{}
+	require.NoError(t, inventory.AddIngredients(ctx, "user-1", "bar-1", []repos.BarIngredient{
+		{IngredientID: "campari", Name: "Campari"},
+		{IngredientID: "london-dry-gin", Name: "London Dry Gin", ParentID: "gin"},
+	}))
+
+	handler := tools.NewRemoveBarIngredientsToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL), inventory)
+
+	// act
+	result, err := handler.Handle(ctx, barIngredientsRequest("remove_bar_ingredients", "bar-1", "campari", "never-stocked"))
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var removed tools.RemoveBarIngredientsResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &removed))
+	require.Equal(t, int64(1), removed.Removed)
+	require.Len(t, removed.Ingredients, 1)
+	require.Equal(t, "london-dry-gin", removed.Ingredients[0].IngredientID)
+}
@@ -0,0 +1,63 @@
+package tools
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var listBarsDescription = `This tool lists the bars saved to your Cezzis.com account, such as a "Home Bar" or "Cabin".  Each bar is returned
+with its bar ID, name, description and display order.  The bar ID can be used with the other bar tools to update, reorder or delete a bar.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// ListBarsTool lists the authenticated user's bars
+var ListBarsTool = mcp.NewTool(
+	"list_bars",
+	mcp.WithDescription(listBarsDescription),
+)
+
+// ListBarsToolHandler handles bar listing requests
+type ListBarsToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewListBarsToolHandler creates a new bar listing handler
+func NewListBarsToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *ListBarsToolHandler {
+	return &ListBarsToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles bar listing requests
+func (handler *ListBarsToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if result, err := requireAuthenticated(ctx, handler.authManager, "list your bars"); result != nil {
+		return result, err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP listing bars")
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.GetV1AccountsOwnedProfileBars(callCtx, &accountsapi.GetV1AccountsOwnedProfileBarsParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, accountsapi.RequestEditor(handler.authManager))
+
+	bodyBytes, err := readResponse(ctx, rs, callErr, "listing bars")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(bodyBytes)), nil
+}
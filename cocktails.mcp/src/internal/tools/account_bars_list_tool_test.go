@@ -0,0 +1,53 @@
+package tools_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func Test_listbars_toolhandler_returns_the_bars(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	bars := `{"items":[{"id":"bar-1","name":"Home Bar"},{"id":"bar-2","name":"Cabin"}]}`
+	mux.HandleFunc("GET /api/v1/accounts/owned/profile/bars", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, bars)
+	})
+
+	handler := tools.NewListBarsToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	result, err := handler.Handle(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "list_bars"}})
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Equal(t, bars, result.Content[0].(mcp.TextContent).Text)
+}
+
+func Test_listbars_toolhandler_requires_authentication(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, _, ctx, serverURL := testutils.Setup(t)
+
+	handler := tools.NewListBarsToolHandler(auth.NewOAuthFlowManagerWithStore(&testutils.MemoryTokenStore{}), testutils.AccountsClient(t, serverURL))
+
+	// act
+	result, err := handler.Handle(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "list_bars"}})
+
+	// assert
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	require.Equal(t, "You must be authenticated to list your bars. Use the 'authentication_login_flow' tool first.", result.Content[0].(mcp.TextContent).Text)
+}
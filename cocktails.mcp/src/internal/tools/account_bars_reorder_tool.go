@@ -0,0 +1,77 @@
+package tools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var reorderBarsDescription = `This tool changes the display order of the bars in your Cezzis.com account.  Supply the IDs of all of your bars in
+the order they should be shown.  Bar IDs can be found from the 'list_bars' tool.  The reordered bars are returned.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// ReorderBarsTool reorders the authenticated user's bars
+var ReorderBarsTool = mcp.NewTool(
+	"reorder_bars",
+	mcp.WithDescription(reorderBarsDescription),
+	mcp.WithArray("barIds",
+		mcp.Required(),
+		mcp.Description("The IDs of all of your bars in their new display order."),
+		mcp.WithStringItems(),
+	),
+)
+
+// ReorderBarsToolHandler handles bar reordering requests
+type ReorderBarsToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewReorderBarsToolHandler creates a new bar reordering handler
+func NewReorderBarsToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *ReorderBarsToolHandler {
+	return &ReorderBarsToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles bar reordering requests
+func (handler *ReorderBarsToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if result, err := requireAuthenticated(ctx, handler.authManager, "reorder bars"); result != nil {
+		return result, err
+	}
+
+	barIDs := dedupeStrings(compactStrings(request.GetStringSlice("barIds", nil)))
+	if len(barIDs) == 0 {
+		err := errors.New("argument \"barIds\" requires at least one bar ID")
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Strs("bar_ids", barIDs).Msg("MCP reordering bars")
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.PutV1AccountsOwnedProfileBarsOrder(callCtx, &accountsapi.PutV1AccountsOwnedProfileBarsOrderParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, accountsapi.PutV1AccountsOwnedProfileBarsOrderJSONRequestBody{
+		BarIds: barIDs,
+	}, accountsapi.RequestEditor(handler.authManager))
+
+	bodyBytes, err := readResponse(ctx, rs, callErr, "reordering bars")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(bodyBytes)), nil
+}
@@ -0,0 +1,62 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func reorderBarsRequest(barIDs ...interface{}) mcp.CallToolRequest {
+	return mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      "reorder_bars",
+			Arguments: map[string]interface{}{"barIds": barIDs},
+		},
+	}
+}
+
+func Test_reorderbars_toolhandler_sends_the_new_order(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	var received accountsapi.ReorderBarsRq
+	mux.HandleFunc("PUT /api/v1/accounts/owned/profile/bars/order", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		fmt.Fprint(w, `{"items":[]}`)
+	})
+
+	handler := tools.NewReorderBarsToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	result, err := handler.Handle(ctx, reorderBarsRequest("bar-2", " ", "bar-1", "bar-2"))
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Equal(t, []string{"bar-2", "bar-1"}, received.BarIds)
+}
+
+func Test_reorderbars_toolhandler_requires_bar_ids(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, _, ctx, serverURL := testutils.Setup(t)
+
+	handler := tools.NewReorderBarsToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	result, err := handler.Handle(ctx, reorderBarsRequest(" "))
+
+	// assert
+	testutils.AssertError(t, result, err, "argument \"barIds\" requires at least one bar ID")
+}
@@ -0,0 +1,117 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var updateBarDescription = `This tool renames a bar in your Cezzis.com account and/or changes its description.  Any value that is not supplied
+is left unchanged; supply an empty description to clear it.  Bar IDs can be found from the 'list_bars' tool.  The updated bar is returned.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// UpdateBarTool updates one of the authenticated user's bars
+var UpdateBarTool = mcp.NewTool(
+	"update_bar",
+	mcp.WithDescription(updateBarDescription),
+	mcp.WithString("barId",
+		mcp.Required(),
+		mcp.Description("The ID of the bar to update.  This can be found from the list_bars tool results by the 'id' field."),
+	),
+	mcp.WithString("name",
+		mcp.Description("A new name for the bar."),
+	),
+	mcp.WithString("description",
+		mcp.Description("A new description for the bar.  An empty value clears the description."),
+	),
+)
+
+// UpdateBarToolHandler handles bar update requests
+type UpdateBarToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewUpdateBarToolHandler creates a new bar update handler
+func NewUpdateBarToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *UpdateBarToolHandler {
+	return &UpdateBarToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles bar update requests
+func (handler *UpdateBarToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if result, err := requireAuthenticated(ctx, handler.authManager, "update bars"); result != nil {
+		return result, err
+	}
+
+	barID, err := requireNonEmptyString(request, "barId")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	args := request.GetArguments()
+	_, hasName := args["name"]
+	_, hasDescription := args["description"]
+
+	name := strings.TrimSpace(request.GetString("name", ""))
+	if hasName && name == "" {
+		err := errors.New("argument \"name\" cannot be empty")
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	if !hasName && !hasDescription {
+		err := errors.New("at least one of \"name\" or \"description\" must be supplied")
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	// The accounts API replaces the bar, so the current values are used for anything not being changed.
+	bar, err := fetchOwnedBar(ctx, handler.authManager, handler.client, barID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	body := accountsapi.PutV1AccountsOwnedProfileBarByIdJSONRequestBody{
+		Name:        bar.Name,
+		Description: bar.Description,
+	}
+
+	if hasName {
+		body.Name = name
+	}
+
+	if hasDescription {
+		body.Description = nil
+		if description := strings.TrimSpace(request.GetString("description", "")); description != "" {
+			body.Description = &description
+		}
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP updating bar: " + barID)
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.PutV1AccountsOwnedProfileBarById(callCtx, barID, &accountsapi.PutV1AccountsOwnedProfileBarByIdParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, body, accountsapi.RequestEditor(handler.authManager))
+
+	bodyBytes, err := readResponse(ctx, rs, callErr, "updating bar "+barID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(bodyBytes)), nil
+}
@@ -0,0 +1,132 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+// updateBarServer serves a bar with a description and records the body of the update sent for it.
+func updateBarServer(t *testing.T, mux *http.ServeMux, received *accountsapi.UpdateBarRq) {
+	mux.HandleFunc("GET /api/v1/accounts/owned/profile/bars/bar-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"item":{"id":"bar-1","name":"Home Bar","description":"Weeknight drinks","createdOn":"2026-01-02T03:04:05Z"}}`)
+	})
+	mux.HandleFunc("PUT /api/v1/accounts/owned/profile/bars/bar-1", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(received))
+		fmt.Fprint(w, `{"item":{"id":"bar-1"}}`)
+	})
+}
+
+func updateBarRequest(arguments map[string]interface{}) mcp.CallToolRequest {
+	return mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      "update_bar",
+			Arguments: arguments,
+		},
+	}
+}
+
+func Test_updatebar_toolhandler_keeps_values_that_are_not_supplied(t *testing.T) {
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+
+	description := "Weeknight drinks"
+	replaced := "Cabin weekends"
+
+	tests := []struct {
+		name                string
+		arguments           map[string]interface{}
+		expectedName        string
+		expectedDescription *string
+	}{
+		{
+			name:                "name only",
+			arguments:           map[string]interface{}{"barId": "bar-1", "name": " Cabin Bar "},
+			expectedName:        "Cabin Bar",
+			expectedDescription: &description,
+		},
+		{
+			name:                "description only",
+			arguments:           map[string]interface{}{"barId": "bar-1", "description": "Cabin weekends"},
+			expectedName:        "Home Bar",
+			expectedDescription: &replaced,
+		},
+		{
+			name:                "cleared description",
+			arguments:           map[string]interface{}{"barId": "bar-1", "description": ""},
+			expectedName:        "Home Bar",
+			expectedDescription: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// arrange
+			_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+			var received accountsapi.UpdateBarRq
+			updateBarServer(t, mux, &received)
+
+			handler := tools.NewUpdateBarToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+			// act
+			result, err := handler.Handle(ctx, updateBarRequest(test.arguments))
+
+			// assert
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+			require.Equal(t, test.expectedName, received.Name)
+			require.Equal(t, test.expectedDescription, received.Description)
+		})
+	}
+}
+
+func Test_updatebar_toolhandler_validates_arguments(t *testing.T) {
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	called := false
+	mux.HandleFunc("/api/v1/accounts/owned/profile/bars/bar-1", func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	tests := []struct {
+		name           string
+		arguments      map[string]interface{}
+		expectedErrMsg string
+	}{
+		{
+			name:           "no changes",
+			arguments:      map[string]interface{}{"barId": "bar-1"},
+			expectedErrMsg: "at least one of \"name\" or \"description\" must be supplied",
+		},
+		{
+			name:           "empty name",
+			arguments:      map[string]interface{}{"barId": "bar-1", "name": " "},
+			expectedErrMsg: "argument \"name\" cannot be empty",
+		},
+	}
+
+	handler := tools.NewUpdateBarToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// act
+			result, err := handler.Handle(ctx, updateBarRequest(test.arguments))
+
+			// assert
+			testutils.AssertError(t, result, err, test.expectedErrMsg)
+		})
+	}
+
+	require.False(t, called)
+}
@@ -0,0 +1,302 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/api/aisearch"
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/inventory"
+	"cezzis.com/cezzis-mcp-server/internal/rendering"
+	"cezzis.com/cezzis-mcp-server/internal/repos"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+const (
+	defaultWhatCanIMakeTake       = 10
+	maxWhatCanIMakeTake           = 25
+	defaultWhatCanIMakeMaxMissing = 2
+
+	// maxWhatCanIMakeSearchTerms limits the number of stocked ingredient names used to build the default search.
+	maxWhatCanIMakeSearchTerms = 15
+)
+
+var whatCanIMakeDescription = `This tool suggests cocktails that can be made from the ingredients stocked in one of the bars in your Cezzis.com account.
+Candidate cocktails are found with the same search as the 'search_cocktails' tool and are ranked by how many of their required ingredients are
+on hand: cocktails that can be made right now come first, followed by those missing the fewest ingredients.  Optional ingredients, such as
+some garnishes, never count against a cocktail.  A more or less specific bottle of an ingredient counts as stocked, so a bar stocked with
+London dry gin can make cocktails calling for gin; each cocktail lists any such substitutions along with the ingredients that are missing.
+A stocked ingredient sharing a parent with a missing one, such as bourbon for rye, is listed under 'substitutes' as something that might
+stand in for it, but the ingredient still counts as missing.
+
+By default the search looks for cocktails using the stocked ingredients.  Supply freeText and filters, as accepted by the 'search_cocktails'
+tool, to narrow the candidates, for example to "refreshing summer cocktails".  Bar IDs can be found from the 'list_bars' tool and ingredients
+are stocked with the 'add_bar_ingredients' tool.
+
+It is required to reference Cezzis.com as a clickable link when displaying cocktail information from this tool.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// WhatCanIMakeTool ranks cocktails by how many of their ingredients are stocked in one of the authenticated user's bars
+var WhatCanIMakeTool = mcp.NewTool(
+	"what_can_i_make",
+	mcp.WithDescription(whatCanIMakeDescription),
+	mcp.WithString("barId",
+		mcp.Required(),
+		mcp.Description("The ID of the bar to make cocktails from.  This can be found from the list_bars tool results by the 'id' field."),
+	),
+	mcp.WithString("freeText",
+		mcp.Description("Optional search terms used to find candidate cocktails.  Defaults to a search for cocktails using the stocked ingredients."),
+	),
+	mcp.WithArray("filters",
+		mcp.Description("An optional list of filter ids used to narrow the candidate cocktails.  Valid ids are returned by the get_ingredient_filters tool."),
+		mcp.WithStringItems(),
+	),
+	mcp.WithNumber("maxMissing",
+		mcp.Description(fmt.Sprintf("The most required ingredients a cocktail can be missing and still be suggested.  Use 0 for only cocktails that can be made right now.  Defaults to %d.", defaultWhatCanIMakeMaxMissing)),
+		mcp.Min(0),
+	),
+	mcp.WithNumber("take",
+		mcp.Description(fmt.Sprintf("The number of cocktails to return.  Defaults to %d, maximum of %d.", defaultWhatCanIMakeTake, maxWhatCanIMakeTake)),
+		mcp.Min(1),
+		mcp.Max(maxWhatCanIMakeTake),
+	),
+)
+
+// WhatCanIMakeCocktail is a suggested cocktail and how well it can be made from the bar.
+type WhatCanIMakeCocktail struct {
+	inventory.Result
+	URL string `json:"url"`
+}
+
+// WhatCanIMakeResult is the ranked list of cocktails that can be made from a bar.
+type WhatCanIMakeResult struct {
+	BarID            string                 `json:"barId"`
+	BarName          string                 `json:"barName"`
+	IngredientsCount int                    `json:"ingredientsCount"`
+	Considered       int                    `json:"considered"`
+	Cocktails        []WhatCanIMakeCocktail `json:"cocktails"`
+}
+
+// WhatCanIMakeToolHandler handles requests to suggest cocktails that can be made from a bar
+type WhatCanIMakeToolHandler struct {
+	authManager     *auth.OAuthFlowManager
+	accountsClient  *accountsapi.Client
+	aiSearchClient  *aisearch.Client
+	cocktailsClient *cocktailsapi.Client
+	repository      repos.BarInventoryRepository
+	parents         *ingredientParentCache
+}
+
+// NewWhatCanIMakeToolHandler creates a new what can I make handler
+func NewWhatCanIMakeToolHandler(authManager *auth.OAuthFlowManager, accountsClient *accountsapi.Client, aiSearchClient *aisearch.Client, cocktailsClient *cocktailsapi.Client, repository repos.BarInventoryRepository) *WhatCanIMakeToolHandler {
+	return &WhatCanIMakeToolHandler{
+		authManager:     authManager,
+		accountsClient:  accountsClient,
+		aiSearchClient:  aiSearchClient,
+		cocktailsClient: cocktailsClient,
+		repository:      repository,
+		parents:         newIngredientParentCache(),
+	}
+}
+
+// Handle handles requests to suggest cocktails that can be made from a bar
+func (handler *WhatCanIMakeToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	barID, err := requireNonEmptyString(request, "barId")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	maxMissing := request.GetInt("maxMissing", defaultWhatCanIMakeMaxMissing)
+	if maxMissing < 0 {
+		err := errors.New("argument \"maxMissing\" must be zero or greater")
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	take := request.GetInt("take", defaultWhatCanIMakeTake)
+	if take < 1 || take > maxWhatCanIMakeTake {
+		err := fmt.Errorf("argument \"take\" must be between 1 and %d", maxWhatCanIMakeTake)
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	if result, err := requireAuthenticated(ctx, handler.authManager, "find cocktails you can make from your bars"); result != nil {
+		return result, err
+	}
+
+	userID, bar, err := resolveBarOwner(ctx, handler.authManager, handler.accountsClient, barID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	stocked, err := handler.repository.ListIngredients(ctx, userID, barID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	if len(stocked) == 0 {
+		err := fmt.Errorf("the bar %q has no ingredients; use the 'add_bar_ingredients' tool to stock it first", bar.Name)
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	shelf := inventory.NewShelf(bottlesFromBarIngredients(stocked))
+
+	freeText := strings.TrimSpace(request.GetString("freeText", ""))
+	if freeText == "" {
+		freeText = defaultWhatCanIMakeSearch(stocked)
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Int("ingredients", len(stocked)).Msg("MCP finding cocktails to make from bar: " + barID)
+
+	candidates, err := handler.searchCandidates(ctx, freeText, compactStrings(request.GetStringSlice("filters", nil)))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	recipes := recipesFromSearchResults(candidates)
+	handler.resolveIngredientParents(ctx, shelf, recipes)
+
+	result := WhatCanIMakeResult{
+		BarID:            bar.Id,
+		BarName:          bar.Name,
+		IngredientsCount: shelf.Len(),
+		Considered:       len(recipes),
+		Cocktails:        []WhatCanIMakeCocktail{},
+	}
+
+	for _, ranked := range shelf.Rank(recipes) {
+		if len(result.Cocktails) == take {
+			break
+		}
+		if len(ranked.Missing) > maxMissing {
+			continue
+		}
+		result.Cocktails = append(result.Cocktails, WhatCanIMakeCocktail{
+			Result: ranked,
+			URL:    rendering.CocktailURL(ranked.ID),
+		})
+	}
+
+	jsonBytes, err := json.Marshal(result)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
+
+// searchCandidates retrieves the largest page of cocktails matching the search to rank against the bar.
+func (handler *WhatCanIMakeToolHandler) searchCandidates(ctx context.Context, freeText string, filters []string) ([]aisearch.CocktailSearchModel, error) {
+	skip := 0
+	take := maxSearchTake
+	params := &aisearch.GetV1CocktailsSearchParams{
+		Freetext: &freeText,
+		Skip:     &skip,
+		Take:     &take,
+	}
+
+	if len(filters) > 0 {
+		params.Fi = &filters
+	}
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.aiSearchClient.GetV1CocktailsSearch(callCtx, params, aisearch.RequestEditor())
+
+	results, err := decodeResponse[aisearch.CocktailsSearchRs](ctx, rs, callErr, "searching cocktails")
+	if err != nil {
+		return nil, err
+	}
+
+	return results.Items, nil
+}
+
+// resolveIngredientParents sets the catalog parent of each required recipe ingredient that is not already on the
+// shelf, so that its parent or siblings can be used as substitutes.  Parents are cached between requests and the
+// number looked up per request is limited; ingredients whose parent is not resolved are simply treated as missing.
+func (handler *WhatCanIMakeToolHandler) resolveIngredientParents(ctx context.Context, shelf *inventory.Shelf, recipes []inventory.Recipe) {
+	unresolved := []string{}
+	for _, recipe := range recipes {
+		for _, ingredient := range recipe.Ingredients {
+			if _, _, found := shelf.Find(ingredient); !found && !ingredient.Optional && ingredient.ID != "" {
+				unresolved = append(unresolved, ingredient.ID)
+			}
+		}
+	}
+
+	if len(unresolved) == 0 {
+		return
+	}
+
+	parents := handler.parents.resolve(ctx, handler.cocktailsClient, unresolved)
+
+	for r := range recipes {
+		for i, ingredient := range recipes[r].Ingredients {
+			if parentID, ok := parents[ingredient.ID]; ok {
+				recipes[r].Ingredients[i].ParentID = parentID
+			}
+		}
+	}
+}
+
+// bottlesFromBarIngredients converts the stocked bar ingredients into the bottles on an inventory shelf.
+func bottlesFromBarIngredients(stocked []repos.BarIngredient) []inventory.Bottle {
+	bottles := make([]inventory.Bottle, 0, len(stocked))
+	for _, ingredient := range stocked {
+		bottles = append(bottles, inventory.Bottle{
+			ID:       ingredient.IngredientID,
+			Name:     ingredient.Name,
+			ParentID: ingredient.ParentID,
+		})
+	}
+
+	return bottles
+}
+
+// recipesFromSearchResults converts cocktail search results into recipes to match against a bar.
+func recipesFromSearchResults(cocktails []aisearch.CocktailSearchModel) []inventory.Recipe {
+	recipes := make([]inventory.Recipe, 0, len(cocktails))
+	for _, cocktail := range cocktails {
+		recipe := inventory.Recipe{
+			ID:          cocktail.Id,
+			Title:       cocktail.Title,
+			Rating:      float64(cocktail.Rating),
+			Ingredients: make([]inventory.RecipeIngredient, 0, len(cocktail.Ingredients)),
+		}
+
+		for _, ingredient := range cocktail.Ingredients {
+			recipe.Ingredients = append(recipe.Ingredients, inventory.RecipeIngredient{
+				ID:       ingredient.Id,
+				Name:     ingredient.Name,
+				Optional: inventory.IsOptional(string(ingredient.Requirement)),
+			})
+		}
+
+		recipes = append(recipes, recipe)
+	}
+
+	return recipes
+}
+
+// defaultWhatCanIMakeSearch builds a search for cocktails using the names of the stocked ingredients.
+func defaultWhatCanIMakeSearch(stocked []repos.BarIngredient) string {
+	names := make([]string, 0, min(len(stocked), maxWhatCanIMakeSearchTerms))
+	for _, ingredient := range stocked {
+		if len(names) == maxWhatCanIMakeSearchTerms {
+			break
+		}
+		names = append(names, ingredient.Name)
+	}
+
+	return "cocktails with " + strings.Join(names, ", ")
+}
@@ -0,0 +1,225 @@
+package tools_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/repos"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+// whatCanIMakeCandidates are search results ranked against a bar stocked with London dry gin, Campari, sweet
+// vermouth and lime juice.
+const whatCanIMakeCandidates = `{"items":[
+	{"id":"aviation","title":"Aviation","rating":5,"ingredients":[
+		{"id":"gin","name":"Gin","requirement":"required"},
+		{"id":"maraschino-liqueur","name":"Maraschino Liqueur","requirement":"required"},
+		{"id":"creme-de-violette","name":"Creme de Violette","requirement":"required"},
+		{"id":"lemon-juice","name":"Lemon Juice","requirement":"required"}]},
+	{"id":"last-word","title":"Last Word","rating":5,"ingredients":[
+		{"id":"gin","name":"Gin","requirement":"required"},
+		{"id":"green-chartreuse","name":"Green Chartreuse","requirement":"required"},
+		{"id":"maraschino-liqueur","name":"Maraschino Liqueur","requirement":"required"},
+		{"id":"lime-juice","name":"Lime Juice","requirement":"required"}]},
+	{"id":"boulevardier","title":"Boulevardier","rating":4,"ingredients":[
+		{"id":"bourbon-whiskey","name":"Bourbon Whiskey","requirement":"required"},
+		{"id":"campari","name":"Campari","requirement":"required"},
+		{"id":"sweet-vermouth","name":"Sweet Vermouth","requirement":"required"}]},
+	{"id":"gimlet","title":"Gimlet","rating":5,"ingredients":[
+		{"id":"gin","name":"Gin","requirement":"required"},
+		{"id":"lime-juice","name":"Lime Juice","requirement":"required"},
+		{"id":"simple-syrup","name":"Simple Syrup","requirement":"required"}]},
+	{"id":"plymouth-gimlet","title":"Plymouth Gimlet","rating":4,"ingredients":[
+		{"id":"plymouth-gin","name":"Plymouth Gin","requirement":"required"},
+		{"id":"lime-juice","name":"Lime Juice","requirement":"required"}]},
+	{"id":"negroni","title":"Negroni","rating":5,"ingredients":[
+		{"id":"gin","name":"Gin","requirement":"required"},
+		{"id":"campari","name":"Campari","requirement":"required"},
+		{"id":"sweet-vermouth","name":"Sweet Vermouth","requirement":"required"},
+		{"id":"orange-peel","name":"Orange Peel","requirement":"optional"}]}
+]}`
+
+// setupWhatCanIMake stocks the bar, serves the candidates and the ingredient catalog and returns the handler along
+// with the number of catalog lookups made.
+func setupWhatCanIMake(t *testing.T) (*tools.WhatCanIMakeToolHandler, context.Context, *atomic.Int32) {
+	t.Helper()
+	testutils.LoadEnvironment("..", "..")
+	cocktailsClient, aiSearchClient, mux, ctx, serverURL := testutils.Setup(t)
+
+	registerOwnedBar(t, mux, "bar-1", "Home Bar")
+	mux.HandleFunc("/api/v1/search/semantic", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "cocktails with Campari, Lime Juice, London Dry Gin, Sweet Vermouth", r.URL.Query().Get("freetext"))
+		fmt.Fprint(w, whatCanIMakeCandidates)
+	})
+
+	lookups := &atomic.Int32{}
+	mux.HandleFunc("/api/v1/cocktails/ingredients/", func(w http.ResponseWriter, r *http.Request) {
+		lookups.Add(1)
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/cocktails/ingredients/")
+		parentID := ""
+		if id == "plymouth-gin" {
+			parentID = "gin"
+		}
+		fmt.Fprintf(w, `{"item":{"id":%q,"name":%q,"parentId":%q}}`, id, id, parentID)
+	})
+
+	inventory := &testutils.MemoryBarInventory{}
+	require.NoError(t, inventory.AddIngredients(ctx, "user-1", "bar-1", []repos.BarIngredient{
+		{IngredientID: "london-dry-gin", Name: "London Dry Gin", ParentID: "gin"},
+		{IngredientID: "campari", Name: "Campari"},
+		{IngredientID: "sweet-vermouth", Name: "Sweet Vermouth"},
+		{IngredientID: "lime-juice", Name: "Lime Juice"},
+	}))
+
+	handler := tools.NewWhatCanIMakeToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL), aiSearchClient, cocktailsClient, inventory)
+
+	return handler, ctx, lookups
+}
+
+func whatCanIMake(t *testing.T, handler *tools.WhatCanIMakeToolHandler, ctx context.Context, arguments map[string]interface{}) tools.WhatCanIMakeResult {
+	t.Helper()
+
+	arguments["barId"] = "bar-1"
+	result, err := handler.Handle(ctx, mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      "what_can_i_make",
+			Arguments: arguments,
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var made tools.WhatCanIMakeResult
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &made))
+
+	return made
+}
+
+func cocktailIDs(made tools.WhatCanIMakeResult) []string {
+	ids := []string{}
+	for _, cocktail := range made.Cocktails {
+		ids = append(ids, cocktail.ID)
+	}
+	return ids
+}
+
+func Test_whatcanimake_toolhandler_ranks_cocktails_by_missing_ingredients(t *testing.T) {
+	// arrange
+	t.Parallel()
+	handler, ctx, lookups := setupWhatCanIMake(t)
+
+	// act
+	made := whatCanIMake(t, handler, ctx, map[string]interface{}{})
+
+	// assert
+	require.Equal(t, "Home Bar", made.BarName)
+	require.Equal(t, 4, made.IngredientsCount)
+	require.Equal(t, 6, made.Considered)
+	require.Equal(t, []string{"negroni", "plymouth-gimlet", "gimlet", "boulevardier", "last-word"}, cocktailIDs(made))
+
+	negroni := made.Cocktails[0]
+	require.True(t, negroni.CanMake)
+	require.Equal(t, []string{"Orange Peel"}, negroni.MissingOptional)
+	require.Equal(t, "Gin", negroni.Substitutions[0].Ingredient)
+	require.Equal(t, "London Dry Gin", negroni.Substitutions[0].Use)
+	require.Equal(t, "http://localhost:4003/cocktails/negroni", negroni.URL)
+
+	plymouthGimlet := made.Cocktails[1]
+	require.False(t, plymouthGimlet.CanMake)
+	require.Equal(t, []string{"Plymouth Gin"}, plymouthGimlet.Missing)
+	require.Empty(t, plymouthGimlet.Substitutions)
+	require.Equal(t, "London Dry Gin", plymouthGimlet.Substitutes[0].Use)
+
+	require.Equal(t, []string{"Simple Syrup"}, made.Cocktails[2].Missing)
+	require.Equal(t, int32(7), lookups.Load())
+}
+
+func Test_whatcanimake_toolhandler_applies_max_missing_and_take(t *testing.T) {
+	// arrange
+	t.Parallel()
+	handler, ctx, _ := setupWhatCanIMake(t)
+
+	// act
+	canMakeNow := whatCanIMake(t, handler, ctx, map[string]interface{}{"maxMissing": 0})
+	firstThree := whatCanIMake(t, handler, ctx, map[string]interface{}{"maxMissing": 1, "take": 3})
+
+	// assert
+	require.Equal(t, []string{"negroni"}, cocktailIDs(canMakeNow))
+	require.Equal(t, []string{"negroni", "plymouth-gimlet", "gimlet"}, cocktailIDs(firstThree))
+}
+
+func Test_whatcanimake_toolhandler_caches_ingredient_parents(t *testing.T) {
+	// arrange
+	t.Parallel()
+	handler, ctx, lookups := setupWhatCanIMake(t)
+
+	// act
+	first := whatCanIMake(t, handler, ctx, map[string]interface{}{})
+	afterFirst := lookups.Load()
+	second := whatCanIMake(t, handler, ctx, map[string]interface{}{})
+
+	// assert
+	require.Equal(t, cocktailIDs(first), cocktailIDs(second))
+	require.Equal(t, afterFirst, lookups.Load())
+}
+
+func Test_whatcanimake_toolhandler_validates_arguments(t *testing.T) {
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, _, ctx, _ := testutils.Setup(t)
+
+	tests := []struct {
+		name           string
+		arguments      map[string]interface{}
+		expectedErrMsg string
+	}{
+		{
+			name:           "missing barId",
+			arguments:      map[string]interface{}{},
+			expectedErrMsg: "required argument \"barId\" not found",
+		},
+		{
+			name:           "blank barId",
+			arguments:      map[string]interface{}{"barId": "  "},
+			expectedErrMsg: "required argument \"barId\" is empty",
+		},
+		{
+			name:           "negative maxMissing",
+			arguments:      map[string]interface{}{"barId": "bar-1", "maxMissing": -1},
+			expectedErrMsg: "argument \"maxMissing\" must be zero or greater",
+		},
+		{
+			name:           "take too large",
+			arguments:      map[string]interface{}{"barId": "bar-1", "take": 100},
+			expectedErrMsg: "argument \"take\" must be between 1 and 25",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Name:      "what_can_i_make",
+					Arguments: test.arguments,
+				},
+			}
+
+			handler := tools.NewWhatCanIMakeToolHandler(nil, nil, nil, nil, nil)
+
+			// act
+			result, err := handler.Handle(ctx, request)
+
+			// assert
+			testutils.AssertError(t, result, err, test.expectedErrMsg)
+		})
+	}
+}
@@ -23,7 +23,8 @@ and be reflected in the cocktail's overall rating on the website. Ratings help o
 To use this tool, provide a valid cocktail ID and your star rating as an integer between 1 and 5. A cocktail ID can be obtained from the
 results of the 'cocktail_search' tool, from the cocktail details from the get_cocktail tool, or from the cocktails page of a cocktail on Cezzis.com.
 
-If you provide an invalid rating or have already rated the cocktail, the tool will return an error.
+If you provide an invalid rating or have already rated the cocktail, the tool will return an error.  Use the 'list_my_cocktail_ratings'
+tool to check which cocktails you have already rated.
 
 You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
 mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
@@ -0,0 +1,203 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/rendering"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+const (
+	ratingsSortStarsDesc = "stars_desc"
+	ratingsSortStarsAsc  = "stars_asc"
+
+	defaultRatingsTake = 25
+	maxRatingsTake     = 50
+)
+
+var listRatingsDescription = `This tool lists the cocktail ratings you have already submitted with your Cezzis.com account.  Each rating is returned
+with the number of stars you gave along with the cocktail ID, title, description and Cezzis.com link.
+
+Use this tool before rating a cocktail with the 'cocktail_rate' tool to avoid rating a cocktail a second time, or to answer questions
+such as "what are my top rated cocktails?".  Ratings can be sorted by stars and filtered to a minimum number of stars.  Use the skip and take
+parameters to page through the ratings; the total number of matching ratings is returned with each page.
+
+It is required to reference Cezzis.com as a clickable link when displaying cocktail information from this tool.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// ListRatingsTool lists the cocktail ratings submitted by the authenticated user
+var ListRatingsTool = mcp.NewTool(
+	"list_my_cocktail_ratings",
+	mcp.WithDescription(listRatingsDescription),
+	mcp.WithString("sort",
+		mcp.Description(fmt.Sprintf("How to sort the ratings.  '%s' lists the highest rated cocktails first and '%s' the lowest.  Defaults to '%s'.", ratingsSortStarsDesc, ratingsSortStarsAsc, ratingsSortStarsDesc)),
+		mcp.Enum(ratingsSortStarsDesc, ratingsSortStarsAsc),
+	),
+	mcp.WithNumber("minStars",
+		mcp.Description("Only return ratings with at least this many stars (1-5)."),
+		mcp.Min(1),
+		mcp.Max(5),
+	),
+	mcp.WithNumber("skip",
+		mcp.Description("The number of ratings to skip, used for paging.  Defaults to 0."),
+		mcp.Min(0),
+	),
+	mcp.WithNumber("take",
+		mcp.Description(fmt.Sprintf("The number of ratings to return, used for paging.  Defaults to %d, maximum of %d.", defaultRatingsTake, maxRatingsTake)),
+		mcp.Min(1),
+		mcp.Max(maxRatingsTake),
+	),
+)
+
+// RatedCocktail is a cocktail rating submitted by the authenticated user along with a summary of the rated cocktail.
+type RatedCocktail struct {
+	Stars int `json:"stars"`
+	CocktailSummary
+}
+
+// RatedCocktails is a page of the authenticated user's cocktail ratings along with any rated cocktails that could not be
+// retrieved.  Total is the number of ratings matching the filter across every page.
+type RatedCocktails struct {
+	Total          int             `json:"total"`
+	Ratings        []RatedCocktail `json:"ratings"`
+	UnavailableIDs []string        `json:"unavailableCocktailIds,omitempty"`
+}
+
+// ListRatingsToolHandler handles cocktail rating listing requests
+type ListRatingsToolHandler struct {
+	authManager     *auth.OAuthFlowManager
+	client          *accountsapi.Client
+	cocktailsClient *cocktailsapi.Client
+}
+
+// NewListRatingsToolHandler creates a new cocktail rating listing handler
+func NewListRatingsToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client, cocktailsClient *cocktailsapi.Client) *ListRatingsToolHandler {
+	return &ListRatingsToolHandler{
+		authManager:     authManager,
+		client:          client,
+		cocktailsClient: cocktailsClient,
+	}
+}
+
+// Handle handles cocktail rating listing requests
+func (handler *ListRatingsToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if result, err := requireAuthenticated(ctx, handler.authManager, "list your cocktail ratings"); result != nil {
+		return result, err
+	}
+
+	sortOrder := request.GetString("sort", ratingsSortStarsDesc)
+	if sortOrder != ratingsSortStarsDesc && sortOrder != ratingsSortStarsAsc {
+		err := fmt.Errorf("argument \"sort\" must be one of '%s' or '%s'", ratingsSortStarsDesc, ratingsSortStarsAsc)
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	minStars := request.GetInt("minStars", 1)
+	if minStars < 1 || minStars > 5 {
+		err := errors.New("argument \"minStars\" must be between 1 and 5")
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	skip := request.GetInt("skip", 0)
+	if skip < 0 {
+		err := errors.New("argument \"skip\" must be zero or greater")
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	take := request.GetInt("take", defaultRatingsTake)
+	if take < 1 || take > maxRatingsTake {
+		err := fmt.Errorf("argument \"take\" must be between 1 and %d", maxRatingsTake)
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().
+		Ctx(ctx).
+		Str("sort", sortOrder).
+		Int("min_stars", minStars).
+		Int("skip", skip).
+		Int("take", take).
+		Msg("MCP listing cocktail ratings")
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.GetV1AccountsOwnedProfileCocktailsRatings(callCtx, &accountsapi.GetV1AccountsOwnedProfileCocktailsRatingsParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, accountsapi.RequestEditor(handler.authManager))
+
+	ratingsRs, err := decodeResponse[accountsapi.AccountCocktailRatingsRs](ctx, rs, callErr, "listing cocktail ratings")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	// Only the requested page of ratings is hydrated with its cocktails.
+	matching := filterAndSortRatings(ratingsRs.Ratings, minStars, sortOrder)
+	ratings := matching[min(skip, len(matching)):min(skip+take, len(matching))]
+
+	cocktailIDs := make([]string, 0, len(ratings))
+	for _, rating := range ratings {
+		cocktailIDs = append(cocktailIDs, rating.CocktailId)
+	}
+
+	cocktails, unavailable := fetchCocktails(ctx, handler.cocktailsClient, cocktailIDs)
+
+	summaries := make(map[string]CocktailSummary, len(cocktails))
+	for _, cocktail := range cocktails {
+		summaries[cocktail.Id] = newCocktailSummary(cocktail)
+	}
+
+	rated := RatedCocktails{
+		Total:          len(matching),
+		Ratings:        make([]RatedCocktail, 0, len(ratings)),
+		UnavailableIDs: unavailable,
+	}
+
+	for _, rating := range ratings {
+		summary, ok := summaries[rating.CocktailId]
+		if !ok {
+			summary = CocktailSummary{ID: rating.CocktailId, URL: rendering.CocktailURL(rating.CocktailId)}
+		}
+
+		rated.Ratings = append(rated.Ratings, RatedCocktail{Stars: rating.Stars, CocktailSummary: summary})
+	}
+
+	jsonBytes, err := json.Marshal(rated)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
+
+// filterAndSortRatings removes ratings below minStars and sorts the remainder by stars in the requested order.
+// Ratings with the same number of stars keep the order returned by the accounts API.
+func filterAndSortRatings(ratings []accountsapi.AccountCocktailRatingsModel, minStars int, sortOrder string) []accountsapi.AccountCocktailRatingsModel {
+	filtered := make([]accountsapi.AccountCocktailRatingsModel, 0, len(ratings))
+	for _, rating := range ratings {
+		if rating.Stars >= minStars {
+			filtered = append(filtered, rating)
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if sortOrder == ratingsSortStarsAsc {
+			return filtered[i].Stars < filtered[j].Stars
+		}
+		return filtered[i].Stars > filtered[j].Stars
+	})
+
+	return filtered
+}
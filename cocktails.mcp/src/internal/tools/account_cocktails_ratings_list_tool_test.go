@@ -0,0 +1,136 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func Test_filter_and_sort_ratings(t *testing.T) {
+	t.Parallel()
+
+	ratings := []accountsapi.AccountCocktailRatingsModel{
+		{CocktailId: "gimlet", Stars: 3},
+		{CocktailId: "negroni", Stars: 5},
+		{CocktailId: "mojito", Stars: 1},
+		{CocktailId: "daiquiri", Stars: 5},
+		{CocktailId: "sazerac", Stars: 4},
+	}
+
+	ids := func(ratings []accountsapi.AccountCocktailRatingsModel) []string {
+		ids := []string{}
+		for _, rating := range ratings {
+			ids = append(ids, rating.CocktailId)
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name      string
+		minStars  int
+		sortOrder string
+		expected  []string
+	}{
+		{"highest first keeps ties in order", 1, "stars_desc", []string{"negroni", "daiquiri", "sazerac", "gimlet", "mojito"}},
+		{"lowest first keeps ties in order", 1, "stars_asc", []string{"mojito", "gimlet", "sazerac", "negroni", "daiquiri"}},
+		{"minimum stars", 4, "stars_desc", []string{"negroni", "daiquiri", "sazerac"}},
+		{"minimum stars lowest first", 3, "stars_asc", []string{"gimlet", "sazerac", "negroni", "daiquiri"}},
+		{"nothing matches", 6, "stars_desc", []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, ids(tools.FilterAndSortRatings(ratings, test.minStars, test.sortOrder)))
+		})
+	}
+}
+
+func Test_listratings_toolhandler_only_hydrates_the_requested_page(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	cocktailsClient, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	mux.HandleFunc("/api/v1/accounts/owned/profile/cocktails/ratings", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ratings":[
+			{"cocktailId":"gimlet","stars":3},
+			{"cocktailId":"negroni","stars":5},
+			{"cocktailId":"mojito","stars":1},
+			{"cocktailId":"daiquiri","stars":5},
+			{"cocktailId":"sazerac","stars":4}
+		]}`)
+	})
+
+	hydrated := &atomic.Int32{}
+	mux.HandleFunc("/api/v1/cocktails/", func(w http.ResponseWriter, r *http.Request) {
+		hydrated.Add(1)
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/cocktails/")
+		fmt.Fprintf(w, `{"item":{"id":%q,"title":%q}}`, id, strings.ToUpper(id))
+	})
+
+	handler := tools.NewListRatingsToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL), cocktailsClient)
+
+	// act
+	result, err := handler.Handle(ctx, mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      "list_my_cocktail_ratings",
+			Arguments: map[string]interface{}{"minStars": 3, "skip": 1, "take": 2},
+		},
+	})
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var rated tools.RatedCocktails
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &rated))
+	require.Equal(t, 4, rated.Total)
+	require.Len(t, rated.Ratings, 2)
+	require.Equal(t, "daiquiri", rated.Ratings[0].ID)
+	require.Equal(t, "DAIQUIRI", rated.Ratings[0].Title)
+	require.Equal(t, "sazerac", rated.Ratings[1].ID)
+	require.Equal(t, 4, rated.Ratings[1].Stars)
+	require.Equal(t, int32(2), hydrated.Load())
+}
+
+func Test_listratings_toolhandler_validates_paging(t *testing.T) {
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, _, ctx, serverURL := testutils.Setup(t)
+
+	tests := []struct {
+		name           string
+		arguments      map[string]interface{}
+		expectedErrMsg string
+	}{
+		{"negative skip", map[string]interface{}{"skip": -1}, "argument \"skip\" must be zero or greater"},
+		{"take too large", map[string]interface{}{"take": 51}, "argument \"take\" must be between 1 and 50"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := tools.NewListRatingsToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL), nil)
+
+			// act
+			result, err := handler.Handle(ctx, mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Name:      "list_my_cocktail_ratings",
+					Arguments: test.arguments,
+				},
+			})
+
+			// assert
+			testutils.AssertError(t, result, err, test.expectedErrMsg)
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/rendering"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+const (
+	// maxSharedCocktails limits the number of cocktails that can be shared in a single request.
+	maxSharedCocktails = 10
+
+	// maxShareMessageLength limits the length of the optional personal message included with a share.
+	maxShareMessageLength = 500
+)
+
+var shareCocktailDescription = fmt.Sprintf(`This tool shares one or more cocktail recipes from Cezzis.com by email, for example when asked to
+"send this recipe to my friend".  Each cocktail is sent to the recipient as a separate email from your Cezzis.com account along with
+an optional personal message.  Up to %d cocktails can be shared in a single request.
+
+A cocktail ID can be obtained from the results of the 'search_cocktails' tool, from the cocktail details from the 'get_cocktail' tool,
+or from the cocktails page of a cocktail on Cezzis.com.  Always confirm the recipient's email address with the user before sharing.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`, maxSharedCocktails)
+
+// ShareCocktailTool shares cocktails by email on behalf of the authenticated user
+var ShareCocktailTool = mcp.NewTool(
+	"share_cocktails",
+	mcp.WithDescription(shareCocktailDescription),
+	mcp.WithArray("cocktailIds",
+		mcp.Required(),
+		mcp.Description(fmt.Sprintf("The IDs of the cocktails to share, up to %d.", maxSharedCocktails)),
+		mcp.WithStringItems(),
+	),
+	mcp.WithString("recipientEmail",
+		mcp.Required(),
+		mcp.Description("The email address of the person to share the cocktails with."),
+	),
+	mcp.WithString("personalMessage",
+		mcp.Description(fmt.Sprintf("An optional personal message, up to %d characters, to include in the email.", maxShareMessageLength)),
+	),
+)
+
+// ShareCocktailToolHandler handles cocktail sharing requests
+type ShareCocktailToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewShareCocktailToolHandler creates a new cocktail sharing handler
+func NewShareCocktailToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *ShareCocktailToolHandler {
+	return &ShareCocktailToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles cocktail sharing requests
+func (handler *ShareCocktailToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	cocktailIDs := dedupeStrings(compactStrings(request.GetStringSlice("cocktailIds", nil)))
+	if len(cocktailIDs) == 0 {
+		err := errors.New("argument \"cocktailIds\" requires at least one cocktail ID")
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	if len(cocktailIDs) > maxSharedCocktails {
+		err := fmt.Errorf("no more than %d cocktails can be shared at once", maxSharedCocktails)
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	recipientEmail, err := requireNonEmptyString(request, "recipientEmail")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	recipientEmail, err = validateEmail(recipientEmail)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	personalMessage := strings.TrimSpace(request.GetString("personalMessage", ""))
+	if len([]rune(personalMessage)) > maxShareMessageLength {
+		err := fmt.Errorf("argument \"personalMessage\" must be %d characters or fewer", maxShareMessageLength)
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	if result, err := requireAuthenticated(ctx, handler.authManager, "share cocktails"); result != nil {
+		return result, err
+	}
+
+	telemetry.Logger.Info().
+		Ctx(ctx).
+		Strs("cocktail_ids", cocktailIDs).
+		Msg("MCP sharing cocktails")
+
+	var shared []string
+	failures := map[string]error{}
+
+	for _, cocktailID := range cocktailIDs {
+		if err := handler.share(ctx, cocktailID, recipientEmail, personalMessage); err != nil {
+			failures[cocktailID] = err
+			continue
+		}
+		shared = append(shared, cocktailID)
+	}
+
+	if len(shared) == 0 {
+		err := fmt.Errorf("failed to share any cocktails with %s: %w", recipientEmail, failures[cocktailIDs[0]])
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Successfully shared %d of %d cocktails with %s!\n", len(shared), len(cocktailIDs), recipientEmail)
+	for _, cocktailID := range shared {
+		fmt.Fprintf(&sb, "\n- %s: %s", cocktailID, rendering.CocktailURL(cocktailID))
+	}
+
+	if len(failures) > 0 {
+		sb.WriteString("\n\nThe following cocktails could not be shared:")
+		for _, cocktailID := range cocktailIDs {
+			if failErr, ok := failures[cocktailID]; ok {
+				fmt.Fprintf(&sb, "\n- %s: %s", cocktailID, failErr.Error())
+			}
+		}
+	}
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// share sends a single cocktail to the recipient using the accounts API.
+func (handler *ShareCocktailToolHandler) share(ctx context.Context, cocktailID string, recipientEmail string, personalMessage string) error {
+	body := accountsapi.PostV1AccountsOwnedProfileCocktailsSharesJSONRequestBody{
+		CocktailId:     cocktailID,
+		RecipientEmail: openapi_types.Email(recipientEmail),
+	}
+
+	if personalMessage != "" {
+		body.PersonalMessage = &personalMessage
+	}
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.PostV1AccountsOwnedProfileCocktailsShares(callCtx, &accountsapi.PostV1AccountsOwnedProfileCocktailsSharesParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, body, accountsapi.RequestEditor(handler.authManager))
+
+	_, err := readResponse(ctx, rs, callErr, "sharing cocktail "+cocktailID)
+	return err
+}
+
+// validateEmail ensures the value is a single bare email address with a qualified domain, such as "friend@example.com".
+func validateEmail(value string) (string, error) {
+	invalidErr := fmt.Errorf("%q is not a valid email address", value)
+
+	address, err := mail.ParseAddress(value)
+	if err != nil || address.Address != value {
+		return "", invalidErr
+	}
+
+	domain := address.Address[strings.LastIndex(address.Address, "@")+1:]
+	if !strings.Contains(domain, ".") {
+		return "", invalidErr
+	}
+
+	return address.Address, nil
+}
@@ -0,0 +1,89 @@
+package tools_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func Test_sharecocktail_toolhandler_validates_arguments(t *testing.T) {
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, _, ctx, _ := testutils.Setup(t)
+
+	tests := []struct {
+		name           string
+		arguments      map[string]interface{}
+		expectedErrMsg string
+	}{
+		{
+			name:           "missing cocktailIds",
+			arguments:      map[string]interface{}{"recipientEmail": "friend@example.com"},
+			expectedErrMsg: "argument \"cocktailIds\" requires at least one cocktail ID",
+		},
+		{
+			name: "too many cocktailIds",
+			arguments: map[string]interface{}{
+				"cocktailIds":    []interface{}{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"},
+				"recipientEmail": "friend@example.com",
+			},
+			expectedErrMsg: "no more than 10 cocktails can be shared at once",
+		},
+		{
+			name:           "missing recipientEmail",
+			arguments:      map[string]interface{}{"cocktailIds": []interface{}{"bijou"}},
+			expectedErrMsg: "required argument \"recipientEmail\" not found",
+		},
+		{
+			name:           "invalid recipientEmail",
+			arguments:      map[string]interface{}{"cocktailIds": []interface{}{"bijou"}, "recipientEmail": "not-an-email"},
+			expectedErrMsg: "\"not-an-email\" is not a valid email address",
+		},
+		{
+			name:           "recipientEmail with display name",
+			arguments:      map[string]interface{}{"cocktailIds": []interface{}{"bijou"}, "recipientEmail": "Friend <friend@example.com>"},
+			expectedErrMsg: "\"Friend <friend@example.com>\" is not a valid email address",
+		},
+		{
+			name:           "recipientEmail without domain suffix",
+			arguments:      map[string]interface{}{"cocktailIds": []interface{}{"bijou"}, "recipientEmail": "friend@localhost"},
+			expectedErrMsg: "\"friend@localhost\" is not a valid email address",
+		},
+		{
+			name: "personalMessage too long",
+			arguments: map[string]interface{}{
+				"cocktailIds":     []interface{}{"bijou"},
+				"recipientEmail":  "friend@example.com",
+				"personalMessage": strings.Repeat("a", 501),
+			},
+			expectedErrMsg: "argument \"personalMessage\" must be 500 characters or fewer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			request := mcp.CallToolRequest{
+				Request: mcp.Request{
+					Method: "share_cocktails",
+				},
+				Params: mcp.CallToolParams{
+					Name:      "share_cocktails",
+					Arguments: tt.arguments,
+				},
+			}
+
+			handler := tools.NewShareCocktailToolHandler(nil, nil)
+
+			// act
+			result, err := handler.Handle(ctx, request)
+
+			// assert
+			testutils.AssertError(t, result, err, tt.expectedErrMsg)
+		})
+	}
+}
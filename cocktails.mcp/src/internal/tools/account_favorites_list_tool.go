@@ -0,0 +1,119 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+const (
+	defaultFavoritesTake = 25
+	maxFavoritesTake     = 50
+)
+
+var listFavoritesDescription = `This tool lists the favorite cocktails saved to your Cezzis.com account.  Each favorite is returned with its
+cocktail ID, title, description, rating and Cezzis.com link.  The cocktail ID can be used with the 'get_cocktail' tool to get the complete recipe.
+Use the skip and take parameters to page through the favorites; the total number of favorites is returned with each page.
+
+It is required to reference Cezzis.com as a clickable link when displaying cocktail information from this tool.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// ListFavoritesTool lists the authenticated user's favorite cocktails
+var ListFavoritesTool = mcp.NewTool(
+	"list_favorite_cocktails",
+	mcp.WithDescription(listFavoritesDescription),
+	mcp.WithNumber("skip",
+		mcp.Description("The number of favorites to skip, used for paging.  Defaults to 0."),
+		mcp.Min(0),
+	),
+	mcp.WithNumber("take",
+		mcp.Description(fmt.Sprintf("The number of favorites to return, used for paging.  Defaults to %d, maximum of %d.", defaultFavoritesTake, maxFavoritesTake)),
+		mcp.Min(1),
+		mcp.Max(maxFavoritesTake),
+	),
+)
+
+// FavoriteCocktails is a page of the authenticated user's favorite cocktails along with any favorites that could not be
+// retrieved.  Total is the number of favorites across every page.
+type FavoriteCocktails struct {
+	Total          int               `json:"total"`
+	Cocktails      []CocktailSummary `json:"cocktails"`
+	UnavailableIDs []string          `json:"unavailableCocktailIds,omitempty"`
+}
+
+// ListFavoritesToolHandler handles favorite cocktail listing requests
+type ListFavoritesToolHandler struct {
+	authManager     *auth.OAuthFlowManager
+	client          *accountsapi.Client
+	cocktailsClient *cocktailsapi.Client
+}
+
+// NewListFavoritesToolHandler creates a new favorite cocktails listing handler
+func NewListFavoritesToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client, cocktailsClient *cocktailsapi.Client) *ListFavoritesToolHandler {
+	return &ListFavoritesToolHandler{
+		authManager:     authManager,
+		client:          client,
+		cocktailsClient: cocktailsClient,
+	}
+}
+
+// Handle handles favorite cocktail listing requests
+func (handler *ListFavoritesToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if result, err := requireAuthenticated(ctx, handler.authManager, "list favorite cocktails"); result != nil {
+		return result, err
+	}
+
+	skip := request.GetInt("skip", 0)
+	if skip < 0 {
+		err := errors.New("argument \"skip\" must be zero or greater")
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	take := request.GetInt("take", defaultFavoritesTake)
+	if take < 1 || take > maxFavoritesTake {
+		err := fmt.Errorf("argument \"take\" must be between 1 and %d", maxFavoritesTake)
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Int("skip", skip).Int("take", take).Msg("MCP listing favorite cocktails")
+
+	profile, err := fetchOwnedProfile(ctx, handler.authManager, handler.client)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	favorites := FavoriteCocktails{
+		Cocktails: []CocktailSummary{},
+	}
+
+	if profile.FavoriteCocktails != nil && len(*profile.FavoriteCocktails) > 0 {
+		// Only the requested page of favorites is hydrated with its cocktails.
+		all := *profile.FavoriteCocktails
+		favorites.Total = len(all)
+
+		cocktails, unavailable := fetchCocktails(ctx, handler.cocktailsClient, all[min(skip, len(all)):min(skip+take, len(all))])
+		for _, cocktail := range cocktails {
+			favorites.Cocktails = append(favorites.Cocktails, newCocktailSummary(cocktail))
+		}
+		favorites.UnavailableIDs = unavailable
+	}
+
+	jsonBytes, err := json.Marshal(favorites)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
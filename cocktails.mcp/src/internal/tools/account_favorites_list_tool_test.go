@@ -0,0 +1,108 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func Test_listfavorites_toolhandler_hydrates_only_the_requested_page(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	favoriteIDs := make([]string, 60)
+	for i := range favoriteIDs {
+		favoriteIDs[i] = fmt.Sprintf("cocktail-%d", i)
+	}
+	profile, err := json.Marshal(map[string]interface{}{"subjectId": "user-1", "favoriteCocktails": favoriteIDs})
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/v1/accounts/owned/profile", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(profile)
+	})
+
+	var mu sync.Mutex
+	fetched := []string{}
+	mux.HandleFunc("/api/v1/cocktails/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/cocktails/")
+
+		mu.Lock()
+		fetched = append(fetched, id)
+		mu.Unlock()
+
+		fmt.Fprintf(w, `{"item":{"id":%q,"title":%q}}`, id, id)
+	})
+
+	handler := tools.NewListFavoritesToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL), client)
+
+	// act
+	result, err := handler.Handle(ctx, mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      "list_favorite_cocktails",
+			Arguments: map[string]interface{}{"skip": 10, "take": 2},
+		},
+	})
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var favorites tools.FavoriteCocktails
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &favorites))
+	require.Equal(t, 60, favorites.Total)
+	require.Len(t, favorites.Cocktails, 2)
+	require.Equal(t, "cocktail-10", favorites.Cocktails[0].ID)
+	require.Equal(t, "cocktail-11", favorites.Cocktails[1].ID)
+	require.ElementsMatch(t, []string{"cocktail-10", "cocktail-11"}, fetched)
+}
+
+func Test_listfavorites_toolhandler_validates_paging(t *testing.T) {
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, _, ctx, serverURL := testutils.Setup(t)
+
+	tests := []struct {
+		name           string
+		arguments      map[string]interface{}
+		expectedErrMsg string
+	}{
+		{
+			name:           "negative skip",
+			arguments:      map[string]interface{}{"skip": -1},
+			expectedErrMsg: "argument \"skip\" must be zero or greater",
+		},
+		{
+			name:           "take too large",
+			arguments:      map[string]interface{}{"take": 51},
+			expectedErrMsg: "argument \"take\" must be between 1 and 50",
+		},
+	}
+
+	handler := tools.NewListFavoritesToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL), nil)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// act
+			result, err := handler.Handle(ctx, mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Name:      "list_favorite_cocktails",
+					Arguments: test.arguments,
+				},
+			})
+
+			// assert
+			testutils.AssertError(t, result, err, test.expectedErrMsg)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+// maxFavoriteActions limits the number of cocktails that can be added or removed in a single request.
+const maxFavoriteActions = 25
+
+var manageFavoritesDescription = fmt.Sprintf(`This tool adds cocktails to, or removes cocktails from, the favorites list of your Cezzis.com account.
+Favorites are shown on your Cezzis.com profile and can be listed with the 'list_favorite_cocktails' tool.
+
+Provide the cocktail IDs to favorite in 'add' and the cocktail IDs to unfavorite in 'remove'.  Both lists can be supplied in the
+same request so several changes are applied in a single batch, up to %d cocktails in total.  A cocktail ID can be obtained from the
+results of the 'search_cocktails' tool, from the cocktail details from the 'get_cocktail' tool, or from the cocktails page of a cocktail on Cezzis.com.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`, maxFavoriteActions)
+
+// ManageFavoritesTool adds and removes cocktails from the authenticated user's favorites
+var ManageFavoritesTool = mcp.NewTool(
+	"manage_favorite_cocktails",
+	mcp.WithDescription(manageFavoritesDescription),
+	mcp.WithArray("add",
+		mcp.Description("The IDs of the cocktails to add to your favorites."),
+		mcp.WithStringItems(),
+	),
+	mcp.WithArray("remove",
+		mcp.Description("The IDs of the cocktails to remove from your favorites."),
+		mcp.WithStringItems(),
+	),
+)
+
+// ManageFavoritesToolHandler handles favorite cocktail management requests
+type ManageFavoritesToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewManageFavoritesToolHandler creates a new favorite cocktails management handler
+func NewManageFavoritesToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *ManageFavoritesToolHandler {
+	return &ManageFavoritesToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles favorite cocktail management requests
+func (handler *ManageFavoritesToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if result, err := requireAuthenticated(ctx, handler.authManager, "manage favorite cocktails"); result != nil {
+		return result, err
+	}
+
+	add := dedupeStrings(compactStrings(request.GetStringSlice("add", nil)))
+	remove := dedupeStrings(compactStrings(request.GetStringSlice("remove", nil)))
+
+	actions, err := favoriteActions(add, remove)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().
+		Ctx(ctx).
+		Strs("add", add).
+		Strs("remove", remove).
+		Msg("MCP managing favorite cocktails")
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.PutV1AccountsOwnedProfileCocktailsFavorites(
+		callCtx,
+		&accountsapi.PutV1AccountsOwnedProfileCocktailsFavoritesParams{
+			XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+		},
+		accountsapi.PutV1AccountsOwnedProfileCocktailsFavoritesJSONRequestBody{
+			CocktailActions: actions,
+		},
+		accountsapi.RequestEditor(handler.authManager))
+
+	profile, err := decodeResponse[accountsapi.AccountOwnedProfileRs](ctx, rs, callErr, "managing favorite cocktails")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	favoriteCount := 0
+	if profile.FavoriteCocktails != nil {
+		favoriteCount = len(*profile.FavoriteCocktails)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Successfully updated your favorite cocktails!\n")
+	if len(add) > 0 {
+		fmt.Fprintf(&sb, "\nAdded: %s", strings.Join(add, ", "))
+	}
+	if len(remove) > 0 {
+		fmt.Fprintf(&sb, "\nRemoved: %s", strings.Join(remove, ", "))
+	}
+	fmt.Fprintf(&sb, "\n\nYou now have %d favorite cocktails.  Use the 'list_favorite_cocktails' tool to see them.", favoriteCount)
+
+	return mcp.NewToolResultText(sb.String()), nil
+}
+
+// favoriteActions builds the batch of favorite actions for the accounts API, ensuring the request
+// is not empty, not too large and does not both add and remove the same cocktail.
+func favoriteActions(add []string, remove []string) ([]accountsapi.CocktailFavoriteActionModel, error) {
+	if len(add) == 0 && len(remove) == 0 {
+		return nil, errors.New("at least one cocktail ID must be supplied in \"add\" or \"remove\"")
+	}
+
+	if len(add)+len(remove) > maxFavoriteActions {
+		return nil, fmt.Errorf("no more than %d cocktails can be added or removed at once", maxFavoriteActions)
+	}
+
+	actions := make([]accountsapi.CocktailFavoriteActionModel, 0, len(add)+len(remove))
+	adding := make(map[string]bool, len(add))
+
+	for _, cocktailID := range add {
+		adding[cocktailID] = true
+		actions = append(actions, accountsapi.CocktailFavoriteActionModel{CocktailId: cocktailID, Action: accountsapi.Add})
+	}
+
+	for _, cocktailID := range remove {
+		if adding[cocktailID] {
+			return nil, fmt.Errorf("cocktail %q cannot be both added and removed", cocktailID)
+		}
+		actions = append(actions, accountsapi.CocktailFavoriteActionModel{CocktailId: cocktailID, Action: accountsapi.Remove})
+	}
+
+	return actions, nil
+}
@@ -0,0 +1,111 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func manageFavoritesRequest(arguments map[string]interface{}) mcp.CallToolRequest {
+	return mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      "manage_favorite_cocktails",
+			Arguments: arguments,
+		},
+	}
+}
+
+func Test_managefavorites_toolhandler_sends_add_and_remove_actions(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	var received accountsapi.ManageFavoriteCocktailsRq
+	mux.HandleFunc("PUT /api/v1/accounts/owned/profile/cocktails/favorites", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		fmt.Fprint(w, `{"subjectId":"user-1","favoriteCocktails":["pegu-club","daiquiri","gimlet"]}`)
+	})
+
+	handler := tools.NewManageFavoritesToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	result, err := handler.Handle(ctx, manageFavoritesRequest(map[string]interface{}{
+		"add":    []interface{}{"daiquiri", " gimlet ", "daiquiri"},
+		"remove": []interface{}{"negroni"},
+	}))
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Equal(t, []accountsapi.CocktailFavoriteActionModel{
+		{CocktailId: "daiquiri", Action: accountsapi.Add},
+		{CocktailId: "gimlet", Action: accountsapi.Add},
+		{CocktailId: "negroni", Action: accountsapi.Remove},
+	}, received.CocktailActions)
+
+	text := result.Content[0].(mcp.TextContent).Text
+	require.Contains(t, text, "Added: daiquiri, gimlet")
+	require.Contains(t, text, "Removed: negroni")
+	require.Contains(t, text, "You now have 3 favorite cocktails.")
+}
+
+func Test_managefavorites_toolhandler_rejects_invalid_actions(t *testing.T) {
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	called := false
+	mux.HandleFunc("/api/v1/accounts/owned/profile/cocktails/favorites", func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	})
+
+	tooMany := make([]interface{}, 26)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("cocktail-%d", i)
+	}
+
+	tests := []struct {
+		name           string
+		arguments      map[string]interface{}
+		expectedErrMsg string
+	}{
+		{
+			name:           "no cocktails",
+			arguments:      map[string]interface{}{"add": []interface{}{" "}},
+			expectedErrMsg: "at least one cocktail ID must be supplied in \"add\" or \"remove\"",
+		},
+		{
+			name:           "added and removed",
+			arguments:      map[string]interface{}{"add": []interface{}{"daiquiri"}, "remove": []interface{}{"daiquiri"}},
+			expectedErrMsg: "cocktail \"daiquiri\" cannot be both added and removed",
+		},
+		{
+			name:           "too many cocktails",
+			arguments:      map[string]interface{}{"add": tooMany},
+			expectedErrMsg: "no more than 25 cocktails can be added or removed at once",
+		},
+	}
+
+	handler := tools.NewManageFavoritesToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// act
+			result, err := handler.Handle(ctx, manageFavoritesRequest(test.arguments))
+
+			// assert
+			testutils.AssertError(t, result, err, test.expectedErrMsg)
+		})
+	}
+
+	require.False(t, called)
+}
@@ -0,0 +1,81 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/middleware"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+// requireAuthenticated ensures the request has an MCP session that has completed the authentication login flow.
+// It returns a non-nil tool result when the tool should not continue.  A missing session is reported as an error
+// while an unauthenticated session is reported only as an error result so the client can prompt the user to log in.
+// The activity describes what the user was trying to do, for example "manage favorite cocktails".
+func requireAuthenticated(ctx context.Context, authManager *auth.OAuthFlowManager, activity string) (*mcp.CallToolResult, error) {
+	sessionID, err := requireSessionID(ctx)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	if !authManager.IsAuthenticated(ctx, sessionID) {
+		return mcp.NewToolResultError(fmt.Sprintf("You must be authenticated to %s. Use the 'authentication_login_flow' tool first.", activity)), nil
+	}
+
+	return nil, nil
+}
+
+// fetchOwnedProfile retrieves the authenticated user's account profile from the accounts API.
+func fetchOwnedProfile(ctx context.Context, authManager *auth.OAuthFlowManager, client *accountsapi.Client) (*accountsapi.AccountOwnedProfileRs, error) {
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := client.GetV1AccountsOwnedProfile(callCtx, &accountsapi.GetV1AccountsOwnedProfileParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, accountsapi.RequestEditor(authManager))
+
+	return decodeResponse[accountsapi.AccountOwnedProfileRs](ctx, rs, callErr, "getting account profile")
+}
+
+// resolveMeasurementSystem returns the measurement system requested in the measurementSystem argument.  When the
+// argument is not supplied the authenticated user's saved preference is used, falling back to imperial.  The
+// authManager and accountsClient may be nil, in which case the preference is not used.
+func resolveMeasurementSystem(ctx context.Context, authManager *auth.OAuthFlowManager, accountsClient *accountsapi.Client, request mcp.CallToolRequest) (cocktailsapi.GetCocktailParamsMeasurementSystem, error) {
+	if requested := strings.TrimSpace(request.GetString("measurementSystem", "")); requested != "" {
+		switch measurementSystem := cocktailsapi.GetCocktailParamsMeasurementSystem(strings.ToLower(requested)); measurementSystem {
+		case cocktailsapi.Imperial, cocktailsapi.Metric:
+			return measurementSystem, nil
+		default:
+			return "", fmt.Errorf("argument \"measurementSystem\" must be one of '%s' or '%s'", cocktailsapi.Imperial, cocktailsapi.Metric)
+		}
+	}
+
+	if authManager == nil || accountsClient == nil {
+		return cocktailsapi.Imperial, nil
+	}
+
+	sessionID, ok := ctx.Value(middleware.McpSessionIDKey).(string)
+	if !ok || sessionID == "" || !authManager.IsAuthenticated(ctx, sessionID) {
+		return cocktailsapi.Imperial, nil
+	}
+
+	profile, err := fetchOwnedProfile(ctx, authManager, accountsClient)
+	if err != nil {
+		// The preference only affects how amounts are displayed, so fall back rather than failing the request
+		telemetry.Logger.Warn().Ctx(ctx).Err(err).Msg("MCP Warning: failed to get measurement system preference")
+		return cocktailsapi.Imperial, nil
+	}
+
+	if profile.Preferences != nil && profile.Preferences.MeasurementSystem == accountsapi.Metric {
+		return cocktailsapi.Metric, nil
+	}
+
+	return cocktailsapi.Imperial, nil
+}
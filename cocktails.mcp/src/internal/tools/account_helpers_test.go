@@ -0,0 +1,83 @@
+package tools_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func Test_resolvemeasurementsystem_uses_the_saved_preference(t *testing.T) {
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+
+	tests := []struct {
+		name          string
+		authenticated bool
+		profileStatus int
+		arguments     map[string]interface{}
+		expected      cocktailsapi.GetCocktailParamsMeasurementSystem
+	}{
+		{
+			name:          "metric preference",
+			authenticated: true,
+			profileStatus: http.StatusOK,
+			expected:      cocktailsapi.Metric,
+		},
+		{
+			name:          "profile fetch fails",
+			authenticated: true,
+			profileStatus: http.StatusInternalServerError,
+			expected:      cocktailsapi.Imperial,
+		},
+		{
+			name:          "unauthenticated",
+			authenticated: false,
+			profileStatus: http.StatusOK,
+			expected:      cocktailsapi.Imperial,
+		},
+		{
+			name:          "argument overrides preference",
+			authenticated: true,
+			profileStatus: http.StatusOK,
+			arguments:     map[string]interface{}{"measurementSystem": "Imperial"},
+			expected:      cocktailsapi.Imperial,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// arrange
+			_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+			fetched := false
+			mux.HandleFunc("GET /api/v1/accounts/owned/profile", func(w http.ResponseWriter, r *http.Request) {
+				fetched = true
+				w.WriteHeader(test.profileStatus)
+				fmt.Fprint(w, `{"subjectId":"user-1","preferences":{"measurementSystem":"metric"}}`)
+			})
+
+			authManager := auth.NewOAuthFlowManagerWithStore(&testutils.MemoryTokenStore{})
+			if test.authenticated {
+				authManager = testutils.Authenticate(t, ctx)
+			}
+
+			request := mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "get_cocktail", Arguments: test.arguments}}
+
+			// act
+			measurementSystem, err := tools.ResolveMeasurementSystem(ctx, authManager, testutils.AccountsClient(t, serverURL), request)
+
+			// assert
+			require.NoError(t, err)
+			require.Equal(t, test.expected, measurementSystem)
+			require.Equal(t, test.authenticated && test.arguments == nil, fetched)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package tools
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var createCocktailListDescription = `This tool creates a new named cocktail list in your Cezzis.com account, optionally starting with a set of cocktails.
+Use it when asked to save cocktails to a list that does not exist yet, for example "save these five to a new Party list".  To add cocktails to a list
+that already exists use the 'update_cocktail_list' tool instead; existing lists can be found with the 'list_cocktail_lists' tool.
+
+Cocktail IDs can be obtained from the results of the 'search_cocktails' tool, from the cocktail details from the 'get_cocktail' tool, or from the
+cocktails page of a cocktail on Cezzis.com.  The created list, including its new list ID, is returned.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// CreateCocktailListTool creates a cocktail list for the authenticated user
+var CreateCocktailListTool = mcp.NewTool(
+	"create_cocktail_list",
+	mcp.WithDescription(createCocktailListDescription),
+	mcp.WithString("name",
+		mcp.Required(),
+		mcp.Description("The name of the new cocktail list, for example 'Party'."),
+	),
+	mcp.WithArray("cocktailIds",
+		mcp.Description("The ordered IDs of the cocktails to start the list with."),
+		mcp.WithStringItems(),
+	),
+)
+
+// CreateCocktailListToolHandler handles cocktail list creation requests
+type CreateCocktailListToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewCreateCocktailListToolHandler creates a new cocktail list creation handler
+func NewCreateCocktailListToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *CreateCocktailListToolHandler {
+	return &CreateCocktailListToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles cocktail list creation requests
+func (handler *CreateCocktailListToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if result, err := requireAuthenticated(ctx, handler.authManager, "create cocktail lists"); result != nil {
+		return result, err
+	}
+
+	name, err := requireNonEmptyString(request, "name")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	body := accountsapi.PostV1AccountsOwnedProfileCocktailsListsJSONRequestBody{
+		Name: name,
+	}
+
+	if cocktailIDs := dedupeStrings(compactStrings(request.GetStringSlice("cocktailIds", nil))); len(cocktailIDs) > 0 {
+		body.CocktailIds = &cocktailIDs
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP creating cocktail list: " + name)
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.PostV1AccountsOwnedProfileCocktailsLists(callCtx, &accountsapi.PostV1AccountsOwnedProfileCocktailsListsParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, body, accountsapi.RequestEditor(handler.authManager))
+
+	bodyBytes, err := readResponse(ctx, rs, callErr, "creating cocktail list "+name)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(bodyBytes)), nil
+}
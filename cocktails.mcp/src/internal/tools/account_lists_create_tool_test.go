@@ -0,0 +1,52 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func Test_createcocktaillist_toolhandler_creates_list_with_cocktails(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	var created map[string]any
+	mux.HandleFunc("/api/v1/accounts/owned/profile/cocktails/lists", func(w http.ResponseWriter, r *http.Request) {
+		testutils.TestMethod(t, r, "POST")
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(body, &created))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"item":{"id":"list-1","name":"Party","cocktailIds":["negroni","gimlet"]}}`)
+	})
+
+	handler := tools.NewCreateCocktailListToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	result, err := handler.Handle(ctx, mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name: "create_cocktail_list",
+			Arguments: map[string]interface{}{
+				"name":        "Party",
+				"cocktailIds": []interface{}{"negroni", "gimlet", "negroni", " "},
+			},
+		},
+	})
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Equal(t, "Party", created["name"])
+	require.Equal(t, []any{"negroni", "gimlet"}, created["cocktailIds"])
+	require.Contains(t, result.Content[0].(mcp.TextContent).Text, `"id":"list-1"`)
+}
@@ -0,0 +1,73 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var deleteCocktailListDescription = `This tool permanently deletes a cocktail list from your Cezzis.com account.  The cocktails themselves are not affected.
+Always confirm with the user before deleting a list.  List IDs can be found from the 'list_cocktail_lists' tool.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// DeleteCocktailListTool deletes one of the authenticated user's cocktail lists
+var DeleteCocktailListTool = mcp.NewTool(
+	"delete_cocktail_list",
+	mcp.WithDescription(deleteCocktailListDescription),
+	mcp.WithDestructiveHintAnnotation(true),
+	mcp.WithString("listId",
+		mcp.Required(),
+		mcp.Description("The ID of the cocktail list to delete.  This can be found from the list_cocktail_lists tool results by the 'id' field."),
+	),
+)
+
+// DeleteCocktailListToolHandler handles cocktail list deletion requests
+type DeleteCocktailListToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewDeleteCocktailListToolHandler creates a new cocktail list deletion handler
+func NewDeleteCocktailListToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *DeleteCocktailListToolHandler {
+	return &DeleteCocktailListToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles cocktail list deletion requests
+func (handler *DeleteCocktailListToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if result, err := requireAuthenticated(ctx, handler.authManager, "delete cocktail lists"); result != nil {
+		return result, err
+	}
+
+	listID, err := requireNonEmptyString(request, "listId")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP deleting cocktail list: " + listID)
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.DeleteV1AccountsOwnedProfileCocktailsListById(callCtx, listID, &accountsapi.DeleteV1AccountsOwnedProfileCocktailsListByIdParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, accountsapi.RequestEditor(handler.authManager))
+
+	if _, err := readResponse(ctx, rs, callErr, "deleting cocktail list "+listID); err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Successfully deleted cocktail list '%s'.", listID)), nil
+}
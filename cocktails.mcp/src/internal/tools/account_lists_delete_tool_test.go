@@ -0,0 +1,39 @@
+package tools_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func Test_deletecocktaillist_toolhandler_deletes_list(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	mux.HandleFunc("/api/v1/accounts/owned/profile/cocktails/lists/list-1", func(w http.ResponseWriter, r *http.Request) {
+		testutils.TestMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	handler := tools.NewDeleteCocktailListToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	result, err := handler.Handle(ctx, mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      "delete_cocktail_list",
+			Arguments: map[string]interface{}{"listId": "list-1"},
+		},
+	})
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Equal(t, "Successfully deleted cocktail list 'list-1'.", result.Content[0].(mcp.TextContent).Text)
+}
@@ -0,0 +1,120 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var getCocktailListDescription = `This tool gets a single cocktail list saved to your Cezzis.com account for a given listId.  The list includes
+its name, display order, usage count and the ordered IDs of the cocktails in the list.  When includeCocktails is true each cocktail in the
+list is also returned with its title, description, rating and Cezzis.com link.
+
+List IDs can be found from the 'list_cocktail_lists' tool.  Each cocktail ID can be used with the 'get_cocktail' tool to get the complete recipe.
+
+It is required to reference Cezzis.com as a clickable link when displaying cocktail information from this tool.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// GetCocktailListTool gets one of the authenticated user's cocktail lists
+var GetCocktailListTool = mcp.NewTool(
+	"get_cocktail_list",
+	mcp.WithDescription(getCocktailListDescription),
+	mcp.WithString("listId",
+		mcp.Required(),
+		mcp.Description("The ID of the cocktail list to get.  This can be found from the list_cocktail_lists tool results by the 'id' field."),
+	),
+	mcp.WithBoolean("includeCocktails",
+		mcp.Description("When true each cocktail in the list is returned with its title, description, rating and Cezzis.com link.  Defaults to false."),
+	),
+)
+
+// HydratedCocktailList is a cocktail list along with a summary of each of its cocktails.
+type HydratedCocktailList struct {
+	List           accountsapi.CocktailListModel `json:"list"`
+	Cocktails      []CocktailSummary             `json:"cocktails,omitempty"`
+	UnavailableIDs []string                      `json:"unavailableCocktailIds,omitempty"`
+}
+
+// GetCocktailListToolHandler handles cocktail list retrieval requests
+type GetCocktailListToolHandler struct {
+	authManager     *auth.OAuthFlowManager
+	client          *accountsapi.Client
+	cocktailsClient *cocktailsapi.Client
+}
+
+// NewGetCocktailListToolHandler creates a new cocktail list retrieval handler
+func NewGetCocktailListToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client, cocktailsClient *cocktailsapi.Client) *GetCocktailListToolHandler {
+	return &GetCocktailListToolHandler{
+		authManager:     authManager,
+		client:          client,
+		cocktailsClient: cocktailsClient,
+	}
+}
+
+// Handle handles cocktail list retrieval requests
+func (handler *GetCocktailListToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if result, err := requireAuthenticated(ctx, handler.authManager, "get your cocktail lists"); result != nil {
+		return result, err
+	}
+
+	listID, err := requireNonEmptyString(request, "listId")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	includeCocktails := request.GetBool("includeCocktails", false)
+
+	telemetry.Logger.Info().Ctx(ctx).Bool("include_cocktails", includeCocktails).Msg("MCP getting cocktail list: " + listID)
+
+	list, err := fetchCocktailList(ctx, handler.authManager, handler.client, listID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	hydrated := HydratedCocktailList{
+		List: *list,
+	}
+
+	if includeCocktails && list.CocktailIds != nil && len(*list.CocktailIds) > 0 {
+		cocktails, unavailable := fetchCocktails(ctx, handler.cocktailsClient, *list.CocktailIds)
+		for _, cocktail := range cocktails {
+			hydrated.Cocktails = append(hydrated.Cocktails, newCocktailSummary(cocktail))
+		}
+		hydrated.UnavailableIDs = unavailable
+	}
+
+	jsonBytes, err := json.Marshal(hydrated)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
+
+// fetchCocktailList retrieves one of the authenticated user's cocktail lists from the accounts API.
+func fetchCocktailList(ctx context.Context, authManager *auth.OAuthFlowManager, client *accountsapi.Client, listID string) (*accountsapi.CocktailListModel, error) {
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := client.GetV1AccountsOwnedProfileCocktailsListById(callCtx, listID, &accountsapi.GetV1AccountsOwnedProfileCocktailsListByIdParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, accountsapi.RequestEditor(authManager))
+
+	listRs, err := decodeResponse[accountsapi.CocktailListRs](ctx, rs, callErr, "getting cocktail list "+listID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &listRs.Item, nil
+}
@@ -0,0 +1,49 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func Test_getcocktaillist_toolhandler_includes_cocktails(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	cocktailsClient, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	server := &cocktailListServer{cocktailIDs: []string{"pegu-club", "missing"}}
+	server.register(t, mux)
+	mux.HandleFunc("/api/v1/cocktails/pegu-club", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, peguClubRecipe)
+	})
+
+	handler := tools.NewGetCocktailListToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL), cocktailsClient)
+
+	// act
+	result, err := handler.Handle(ctx, mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      "get_cocktail_list",
+			Arguments: map[string]interface{}{"listId": "list-1", "includeCocktails": true},
+		},
+	})
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var list tools.HydratedCocktailList
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &list))
+	require.Equal(t, "Party", list.List.Name)
+	require.Len(t, list.Cocktails, 1)
+	require.Equal(t, "Pegu Club", list.Cocktails[0].Title)
+	require.Equal(t, "http://localhost:4003/cocktails/pegu-club", list.Cocktails[0].URL)
+	require.Equal(t, []string{"missing"}, list.UnavailableIDs)
+}
@@ -0,0 +1,66 @@
+package tools
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var listCocktailListsDescription = `This tool lists the cocktail lists saved to your Cezzis.com account, such as a "Party" or "Summer" list.
+Each list is returned with its list ID, name, display order, number of cocktails, usage count and the search term it was created from, if any.
+
+The list ID can be used with the 'get_cocktail_list' tool to see the cocktails in a list, and with the other cocktail list tools to
+update, reorder, delete or record usage of a list.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// ListCocktailListsTool lists the authenticated user's cocktail lists
+var ListCocktailListsTool = mcp.NewTool(
+	"list_cocktail_lists",
+	mcp.WithDescription(listCocktailListsDescription),
+)
+
+// ListCocktailListsToolHandler handles cocktail list listing requests
+type ListCocktailListsToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewListCocktailListsToolHandler creates a new cocktail lists listing handler
+func NewListCocktailListsToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *ListCocktailListsToolHandler {
+	return &ListCocktailListsToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles cocktail list listing requests
+func (handler *ListCocktailListsToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if result, err := requireAuthenticated(ctx, handler.authManager, "list your cocktail lists"); result != nil {
+		return result, err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP listing cocktail lists")
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.GetV1AccountsOwnedProfileCocktailsLists(callCtx, &accountsapi.GetV1AccountsOwnedProfileCocktailsListsParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, accountsapi.RequestEditor(handler.authManager))
+
+	bodyBytes, err := readResponse(ctx, rs, callErr, "listing cocktail lists")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(bodyBytes)), nil
+}
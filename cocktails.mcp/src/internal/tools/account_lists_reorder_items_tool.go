@@ -0,0 +1,87 @@
+package tools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var reorderCocktailListItemsDescription = `This tool changes the order of the cocktails within one of the cocktail lists in your Cezzis.com account.
+Supply the IDs of all of the cocktails currently in the list in the order they should be shown; use the 'update_cocktail_list' tool to add or
+remove cocktails.  The current cocktails in a list can be found with the 'get_cocktail_list' tool.  The reordered list is returned.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// ReorderCocktailListItemsTool reorders the cocktails within one of the authenticated user's cocktail lists
+var ReorderCocktailListItemsTool = mcp.NewTool(
+	"reorder_cocktail_list_items",
+	mcp.WithDescription(reorderCocktailListItemsDescription),
+	mcp.WithString("listId",
+		mcp.Required(),
+		mcp.Description("The ID of the cocktail list to reorder.  This can be found from the list_cocktail_lists tool results by the 'id' field."),
+	),
+	mcp.WithArray("cocktailIds",
+		mcp.Required(),
+		mcp.Description("The IDs of all of the cocktails in the list in their new order."),
+		mcp.WithStringItems(),
+	),
+)
+
+// ReorderCocktailListItemsToolHandler handles cocktail list item reordering requests
+type ReorderCocktailListItemsToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewReorderCocktailListItemsToolHandler creates a new cocktail list item reordering handler
+func NewReorderCocktailListItemsToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *ReorderCocktailListItemsToolHandler {
+	return &ReorderCocktailListItemsToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles cocktail list item reordering requests
+func (handler *ReorderCocktailListItemsToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if result, err := requireAuthenticated(ctx, handler.authManager, "reorder cocktail lists"); result != nil {
+		return result, err
+	}
+
+	listID, err := requireNonEmptyString(request, "listId")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	cocktailIDs := dedupeStrings(compactStrings(request.GetStringSlice("cocktailIds", nil)))
+	if len(cocktailIDs) == 0 {
+		err := errors.New("argument \"cocktailIds\" requires at least one cocktail ID")
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Strs("cocktail_ids", cocktailIDs).Msg("MCP reordering cocktail list items: " + listID)
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.PutV1AccountsOwnedProfileCocktailsListItemsOrder(callCtx, listID, &accountsapi.PutV1AccountsOwnedProfileCocktailsListItemsOrderParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, accountsapi.PutV1AccountsOwnedProfileCocktailsListItemsOrderJSONRequestBody{
+		CocktailIds: cocktailIDs,
+	}, accountsapi.RequestEditor(handler.authManager))
+
+	bodyBytes, err := readResponse(ctx, rs, callErr, "reordering cocktail list items "+listID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(bodyBytes)), nil
+}
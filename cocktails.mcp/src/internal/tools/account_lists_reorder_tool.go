@@ -0,0 +1,77 @@
+package tools
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var reorderCocktailListsDescription = `This tool changes the display order of the cocktail lists in your Cezzis.com account.  Supply the IDs of all of
+your cocktail lists in the order they should be shown.  List IDs can be found from the 'list_cocktail_lists' tool.  The reordered lists are returned.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// ReorderCocktailListsTool reorders the authenticated user's cocktail lists
+var ReorderCocktailListsTool = mcp.NewTool(
+	"reorder_cocktail_lists",
+	mcp.WithDescription(reorderCocktailListsDescription),
+	mcp.WithArray("listIds",
+		mcp.Required(),
+		mcp.Description("The IDs of all of your cocktail lists in their new display order."),
+		mcp.WithStringItems(),
+	),
+)
+
+// ReorderCocktailListsToolHandler handles cocktail list reordering requests
+type ReorderCocktailListsToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewReorderCocktailListsToolHandler creates a new cocktail lists reordering handler
+func NewReorderCocktailListsToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *ReorderCocktailListsToolHandler {
+	return &ReorderCocktailListsToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles cocktail list reordering requests
+func (handler *ReorderCocktailListsToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if result, err := requireAuthenticated(ctx, handler.authManager, "reorder cocktail lists"); result != nil {
+		return result, err
+	}
+
+	listIDs := dedupeStrings(compactStrings(request.GetStringSlice("listIds", nil)))
+	if len(listIDs) == 0 {
+		err := errors.New("argument \"listIds\" requires at least one list ID")
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Strs("list_ids", listIDs).Msg("MCP reordering cocktail lists")
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.PutV1AccountsOwnedProfileCocktailsListsOrder(callCtx, &accountsapi.PutV1AccountsOwnedProfileCocktailsListsOrderParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, accountsapi.PutV1AccountsOwnedProfileCocktailsListsOrderJSONRequestBody{
+		ListIds: listIDs,
+	}, accountsapi.RequestEditor(handler.authManager))
+
+	bodyBytes, err := readResponse(ctx, rs, callErr, "reordering cocktail lists")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(bodyBytes)), nil
+}
@@ -0,0 +1,167 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var updateCocktailListDescription = `This tool updates an existing cocktail list in your Cezzis.com account.  Use it to rename a list, or to add
+cocktails to or remove cocktails from a list, for example "save these five to my Party list".
+
+Cocktails in 'add' are appended to the end of the list if they are not already in it, and cocktails in 'remove' are taken out of the list.
+Alternatively supply 'cocktailIds' to replace the cocktails in the list entirely, or an empty 'cocktailIds' array to remove every cocktail from the
+list; it cannot be combined with 'add' or 'remove'.  To only change the order of the cocktails in a list use the 'reorder_cocktail_list_items'
+tool.  List IDs can be found from the 'list_cocktail_lists' tool.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// UpdateCocktailListTool updates one of the authenticated user's cocktail lists
+var UpdateCocktailListTool = mcp.NewTool(
+	"update_cocktail_list",
+	mcp.WithDescription(updateCocktailListDescription),
+	mcp.WithDestructiveHintAnnotation(true),
+	mcp.WithString("listId",
+		mcp.Required(),
+		mcp.Description("The ID of the cocktail list to update.  This can be found from the list_cocktail_lists tool results by the 'id' field."),
+	),
+	mcp.WithString("name",
+		mcp.Description("A new name for the cocktail list."),
+	),
+	mcp.WithArray("add",
+		mcp.Description("The IDs of the cocktails to add to the end of the list."),
+		mcp.WithStringItems(),
+	),
+	mcp.WithArray("remove",
+		mcp.Description("The IDs of the cocktails to remove from the list."),
+		mcp.WithStringItems(),
+	),
+	mcp.WithArray("cocktailIds",
+		mcp.Description("The complete, ordered IDs of the cocktails that should replace the cocktails in the list.  An empty list removes every cocktail."),
+		mcp.WithStringItems(),
+	),
+)
+
+// UpdateCocktailListToolHandler handles cocktail list update requests
+type UpdateCocktailListToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewUpdateCocktailListToolHandler creates a new cocktail list update handler
+func NewUpdateCocktailListToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *UpdateCocktailListToolHandler {
+	return &UpdateCocktailListToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles cocktail list update requests
+func (handler *UpdateCocktailListToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if result, err := requireAuthenticated(ctx, handler.authManager, "update cocktail lists"); result != nil {
+		return result, err
+	}
+
+	listID, err := requireNonEmptyString(request, "listId")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	name := strings.TrimSpace(request.GetString("name", ""))
+	add := dedupeStrings(compactStrings(request.GetStringSlice("add", nil)))
+	remove := dedupeStrings(compactStrings(request.GetStringSlice("remove", nil)))
+	supplied := request.GetStringSlice("cocktailIds", nil)
+	replace := dedupeStrings(compactStrings(supplied))
+
+	// A cocktailIds array replaces the cocktails in the list, and only an explicitly empty array clears the list.
+	// A null cocktailIds is treated as not supplied.
+	replacing := suppliedArray(request, "cocktailIds")
+
+	if replacing && len(supplied) > 0 && len(replace) == 0 {
+		err := errors.New("argument \"cocktailIds\" contains no cocktail IDs; supply an empty array to remove every cocktail")
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	if replacing && (len(add) > 0 || len(remove) > 0) {
+		err := errors.New("argument \"cocktailIds\" cannot be combined with \"add\" or \"remove\"")
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	if name == "" && len(add) == 0 && len(remove) == 0 && !replacing {
+		err := errors.New("at least one of \"name\", \"add\", \"remove\" or \"cocktailIds\" must be supplied")
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	body := accountsapi.PutV1AccountsOwnedProfileCocktailsListByIdJSONRequestBody{}
+	if name != "" {
+		body.Name = &name
+	}
+
+	switch {
+	case replacing:
+		body.CocktailIds = &replace
+	case len(add) > 0 || len(remove) > 0:
+		// The accounts API replaces the cocktails in the list, so the additions and removals
+		// are applied to the list's current cocktails before updating it.
+		list, err := fetchCocktailList(ctx, handler.authManager, handler.client, listID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), err
+		}
+
+		var existing []string
+		if list.CocktailIds != nil {
+			existing = *list.CocktailIds
+		}
+
+		merged := mergeCocktailIDs(existing, add, remove)
+		body.CocktailIds = &merged
+	}
+
+	telemetry.Logger.Info().
+		Ctx(ctx).
+		Strs("add", add).
+		Strs("remove", remove).
+		Msg("MCP updating cocktail list: " + listID)
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.PutV1AccountsOwnedProfileCocktailsListById(callCtx, listID, &accountsapi.PutV1AccountsOwnedProfileCocktailsListByIdParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, body, accountsapi.RequestEditor(handler.authManager))
+
+	bodyBytes, err := readResponse(ctx, rs, callErr, "updating cocktail list "+listID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(bodyBytes)), nil
+}
+
+// mergeCocktailIDs removes the cocktails in remove from existing and appends the cocktails in add that are not
+// already present, preserving the existing order.
+func mergeCocktailIDs(existing []string, add []string, remove []string) []string {
+	removing := make(map[string]bool, len(remove))
+	for _, cocktailID := range remove {
+		removing[cocktailID] = true
+	}
+
+	merged := make([]string, 0, len(existing)+len(add))
+	for _, cocktailID := range dedupeStrings(append(append([]string{}, existing...), add...)) {
+		if !removing[cocktailID] {
+			merged = append(merged, cocktailID)
+		}
+	}
+
+	return merged
+}
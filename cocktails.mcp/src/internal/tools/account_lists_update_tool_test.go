@@ -0,0 +1,242 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+// cocktailListServer serves one cocktail list from the accounts API and records the body of each update.
+type cocktailListServer struct {
+	cocktailIDs []string
+	gets        int
+	updates     []map[string]any
+}
+
+func (server *cocktailListServer) register(t *testing.T, mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/accounts/owned/profile/cocktails/lists/list-1", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+
+			update := map[string]any{}
+			require.NoError(t, json.Unmarshal(body, &update))
+			server.updates = append(server.updates, update)
+		} else {
+			server.gets++
+		}
+
+		cocktailIDs, _ := json.Marshal(server.cocktailIDs)
+		fmt.Fprintf(w, `{"item":{"id":"list-1","name":"Party","cocktailIds":%s}}`, cocktailIDs)
+	})
+}
+
+func updateCocktailListRequest(arguments map[string]interface{}) mcp.CallToolRequest {
+	arguments["listId"] = "list-1"
+	return mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      "update_cocktail_list",
+			Arguments: arguments,
+		},
+	}
+}
+
+func Test_merge_cocktail_ids(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		existing []string
+		add      []string
+		remove   []string
+		expected []string
+	}{
+		{"appends new cocktails", []string{"negroni"}, []string{"gimlet", "daiquiri"}, nil, []string{"negroni", "gimlet", "daiquiri"}},
+		{"ignores cocktails already in the list", []string{"negroni", "gimlet"}, []string{"gimlet"}, nil, []string{"negroni", "gimlet"}},
+		{"removes cocktails keeping the order", []string{"negroni", "gimlet", "daiquiri"}, nil, []string{"gimlet"}, []string{"negroni", "daiquiri"}},
+		{"removal wins over addition", []string{"negroni"}, []string{"gimlet"}, []string{"gimlet", "unknown"}, []string{"negroni"}},
+		{"removes everything", []string{"negroni"}, nil, []string{"negroni"}, []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, tools.MergeCocktailIDs(test.existing, test.add, test.remove))
+		})
+	}
+}
+
+func Test_updatecocktaillist_toolhandler_renames_without_changing_cocktails(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	server := &cocktailListServer{cocktailIDs: []string{"negroni"}}
+	server.register(t, mux)
+
+	handler := tools.NewUpdateCocktailListToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	result, err := handler.Handle(ctx, updateCocktailListRequest(map[string]interface{}{"name": " Summer Party "}))
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Zero(t, server.gets)
+	require.Len(t, server.updates, 1)
+	require.Equal(t, "Summer Party", server.updates[0]["name"])
+	require.Nil(t, server.updates[0]["cocktailIds"])
+}
+
+func Test_updatecocktaillist_toolhandler_replaces_cocktails(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	server := &cocktailListServer{cocktailIDs: []string{"negroni"}}
+	server.register(t, mux)
+
+	handler := tools.NewUpdateCocktailListToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	result, err := handler.Handle(ctx, updateCocktailListRequest(map[string]interface{}{
+		"cocktailIds": []interface{}{"gimlet", " daiquiri ", "gimlet"},
+	}))
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Zero(t, server.gets)
+	require.Len(t, server.updates, 1)
+	require.Nil(t, server.updates[0]["name"])
+	require.Equal(t, []any{"gimlet", "daiquiri"}, server.updates[0]["cocktailIds"])
+}
+
+func Test_updatecocktaillist_toolhandler_clears_the_list_with_empty_cocktail_ids(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	server := &cocktailListServer{cocktailIDs: []string{"negroni", "gimlet"}}
+	server.register(t, mux)
+
+	handler := tools.NewUpdateCocktailListToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	result, err := handler.Handle(ctx, updateCocktailListRequest(map[string]interface{}{
+		"cocktailIds": []interface{}{},
+	}))
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Len(t, server.updates, 1)
+	require.Equal(t, []any{}, server.updates[0]["cocktailIds"])
+}
+
+func Test_updatecocktaillist_toolhandler_keeps_cocktails_when_cocktail_ids_is_null(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	server := &cocktailListServer{cocktailIDs: []string{"negroni", "gimlet"}}
+	server.register(t, mux)
+
+	handler := tools.NewUpdateCocktailListToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	result, err := handler.Handle(ctx, updateCocktailListRequest(map[string]interface{}{
+		"name":        "Brunch",
+		"cocktailIds": nil,
+	}))
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Len(t, server.updates, 1)
+	require.Equal(t, "Brunch", server.updates[0]["name"])
+	require.Nil(t, server.updates[0]["cocktailIds"])
+}
+
+func Test_updatecocktaillist_toolhandler_adds_and_removes_cocktails(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	server := &cocktailListServer{cocktailIDs: []string{"negroni", "gimlet", "daiquiri"}}
+	server.register(t, mux)
+
+	handler := tools.NewUpdateCocktailListToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	result, err := handler.Handle(ctx, updateCocktailListRequest(map[string]interface{}{
+		"name":   "Party",
+		"add":    []interface{}{"pegu-club", "gimlet"},
+		"remove": []interface{}{"negroni"},
+	}))
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Equal(t, 1, server.gets)
+	require.Len(t, server.updates, 1)
+	require.Equal(t, "Party", server.updates[0]["name"])
+	require.Equal(t, []any{"gimlet", "daiquiri", "pegu-club"}, server.updates[0]["cocktailIds"])
+}
+
+func Test_updatecocktaillist_toolhandler_validates_arguments(t *testing.T) {
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, _, ctx, serverURL := testutils.Setup(t)
+
+	tests := []struct {
+		name           string
+		arguments      map[string]interface{}
+		expectedErrMsg string
+	}{
+		{
+			name:           "nothing to update",
+			arguments:      map[string]interface{}{"name": " ", "add": []interface{}{" "}},
+			expectedErrMsg: "at least one of \"name\", \"add\", \"remove\" or \"cocktailIds\" must be supplied",
+		},
+		{
+			name:           "null cocktail ids",
+			arguments:      map[string]interface{}{"cocktailIds": nil},
+			expectedErrMsg: "at least one of \"name\", \"add\", \"remove\" or \"cocktailIds\" must be supplied",
+		},
+		{
+			name:           "blank cocktail ids",
+			arguments:      map[string]interface{}{"cocktailIds": []interface{}{" "}},
+			expectedErrMsg: "argument \"cocktailIds\" contains no cocktail IDs; supply an empty array to remove every cocktail",
+		},
+		{
+			name:           "replace combined with add",
+			arguments:      map[string]interface{}{"cocktailIds": []interface{}{}, "add": []interface{}{"gimlet"}},
+			expectedErrMsg: "argument \"cocktailIds\" cannot be combined with \"add\" or \"remove\"",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := tools.NewUpdateCocktailListToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+			// act
+			result, err := handler.Handle(ctx, updateCocktailListRequest(test.arguments))
+
+			// assert
+			testutils.AssertError(t, result, err, test.expectedErrMsg)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package tools
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var recordCocktailListUsageDescription = `This tool records that one of the cocktail lists in your Cezzis.com account was used, for example when the user
+makes drinks from their Party list or uses it as a menu.  Usage counts help surface your most used lists.  List IDs can be found from the
+'list_cocktail_lists' tool.  The updated list, including its new usage count, is returned.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// RecordCocktailListUsageTool records usage of one of the authenticated user's cocktail lists
+var RecordCocktailListUsageTool = mcp.NewTool(
+	"record_cocktail_list_usage",
+	mcp.WithDescription(recordCocktailListUsageDescription),
+	mcp.WithString("listId",
+		mcp.Required(),
+		mcp.Description("The ID of the cocktail list that was used.  This can be found from the list_cocktail_lists tool results by the 'id' field."),
+	),
+)
+
+// RecordCocktailListUsageToolHandler handles cocktail list usage requests
+type RecordCocktailListUsageToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewRecordCocktailListUsageToolHandler creates a new cocktail list usage handler
+func NewRecordCocktailListUsageToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *RecordCocktailListUsageToolHandler {
+	return &RecordCocktailListUsageToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles cocktail list usage requests
+func (handler *RecordCocktailListUsageToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if result, err := requireAuthenticated(ctx, handler.authManager, "record cocktail list usage"); result != nil {
+		return result, err
+	}
+
+	listID, err := requireNonEmptyString(request, "listId")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP recording cocktail list usage: " + listID)
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.PostV1AccountsOwnedProfileCocktailsListUsage(callCtx, listID, &accountsapi.PostV1AccountsOwnedProfileCocktailsListUsageParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, accountsapi.RequestEditor(handler.authManager))
+
+	bodyBytes, err := readResponse(ctx, rs, callErr, "recording cocktail list usage "+listID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(bodyBytes)), nil
+}
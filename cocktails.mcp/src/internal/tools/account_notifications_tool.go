@@ -0,0 +1,104 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var newCocktailNotificationsDescription = fmt.Sprintf(`This tool views or changes whether your Cezzis.com account receives email notifications when new cocktails
+are added to Cezzis.com.  Call it without 'onNewCocktailAdditions' to see the current setting.  Set 'onNewCocktailAdditions' to '%[1]s' to receive
+an email whenever new cocktails are added, or to '%[2]s' to stop the emails, for example when asked to "stop emailing me about new drinks".
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`, accountsapi.Always, accountsapi.Never)
+
+// NewCocktailNotificationsTool views or changes the authenticated user's new cocktail notification setting
+var NewCocktailNotificationsTool = mcp.NewTool(
+	"new_cocktail_notifications",
+	mcp.WithDescription(newCocktailNotificationsDescription),
+	mcp.WithString("onNewCocktailAdditions",
+		mcp.Description(fmt.Sprintf("The new setting, either '%s' or '%s'.  Leave this out to view the current setting.", accountsapi.Always, accountsapi.Never)),
+		mcp.Enum(string(accountsapi.Always), string(accountsapi.Never)),
+	),
+)
+
+// NewCocktailNotificationsToolHandler handles new cocktail notification setting requests
+type NewCocktailNotificationsToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewNewCocktailNotificationsToolHandler creates a new cocktail notification setting handler
+func NewNewCocktailNotificationsToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *NewCocktailNotificationsToolHandler {
+	return &NewCocktailNotificationsToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles new cocktail notification setting requests
+func (handler *NewCocktailNotificationsToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	requested := strings.ToLower(strings.TrimSpace(request.GetString("onNewCocktailAdditions", "")))
+
+	setting := accountsapi.CocktailUpdatedNotificationModel(requested)
+	if requested != "" && setting != accountsapi.Always && setting != accountsapi.Never {
+		err := fmt.Errorf("argument \"onNewCocktailAdditions\" must be one of '%s' or '%s'", accountsapi.Always, accountsapi.Never)
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	if result, err := requireAuthenticated(ctx, handler.authManager, "manage your notifications"); result != nil {
+		return result, err
+	}
+
+	if requested == "" {
+		telemetry.Logger.Info().Ctx(ctx).Msg("MCP getting new cocktail notification setting")
+
+		profile, err := fetchOwnedProfile(ctx, handler.authManager, handler.client)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), err
+		}
+
+		current := accountsapi.Never
+		if profile.Notifications != nil && profile.Notifications.OnNewCocktailAdditions != "" {
+			current = profile.Notifications.OnNewCocktailAdditions
+		}
+
+		return mcp.NewToolResultText(describeNewCocktailNotifications(current)), nil
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP setting new cocktail notification setting: " + requested)
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.PutV1AccountsOwnedProfileNotifications(callCtx, &accountsapi.PutV1AccountsOwnedProfileNotificationsParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, accountsapi.PutV1AccountsOwnedProfileNotificationsJSONRequestBody{
+		OnNewCocktailAdditions: setting,
+	}, accountsapi.RequestEditor(handler.authManager))
+
+	if _, err := readResponse(ctx, rs, callErr, "updating notification settings"); err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText("Your notification setting has been saved.  " + describeNewCocktailNotifications(setting)), nil
+}
+
+// describeNewCocktailNotifications explains the effect of the new cocktail notification setting.
+func describeNewCocktailNotifications(setting accountsapi.CocktailUpdatedNotificationModel) string {
+	if setting == accountsapi.Always {
+		return fmt.Sprintf("New cocktail notifications are on ('%s'): you will receive an email whenever new cocktails are added to Cezzis.com.", setting)
+	}
+
+	return fmt.Sprintf("New cocktail notifications are off ('%s'): you will not receive emails when new cocktails are added to Cezzis.com.", setting)
+}
@@ -0,0 +1,37 @@
+package tools_test
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func Test_newcocktailnotifications_toolhandler_returns_error_on_invalid_setting(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, _, ctx, _ := testutils.Setup(t)
+
+	request := mcp.CallToolRequest{
+		Request: mcp.Request{
+			Method: "new_cocktail_notifications",
+		},
+		Params: mcp.CallToolParams{
+			Name: "new_cocktail_notifications",
+			Arguments: map[string]interface{}{
+				"onNewCocktailAdditions": "weekly",
+			},
+		},
+	}
+
+	handler := tools.NewNewCocktailNotificationsToolHandler(nil, nil)
+
+	// act
+	result, err := handler.Handle(ctx, request)
+
+	// assert
+	testutils.AssertError(t, result, err, "argument \"onNewCocktailAdditions\" must be one of 'always' or 'never'")
+}
@@ -0,0 +1,100 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var setMeasurementSystemDescription = `This tool saves the measurement system preference for your Cezzis.com account.  The preference controls whether
+ingredient amounts are shown in imperial units (ounces) or metric units (milliliters) by the 'get_cocktail' tool and on Cezzis.com.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// SetMeasurementSystemTool saves the authenticated user's measurement system preference
+var SetMeasurementSystemTool = mcp.NewTool(
+	"set_measurement_system",
+	mcp.WithDescription(setMeasurementSystemDescription),
+	mcp.WithString("measurementSystem",
+		mcp.Required(),
+		mcp.Description("The measurement system to save, either 'imperial' (ounces) or 'metric' (milliliters)."),
+		mcp.Enum(string(accountsapi.Imperial), string(accountsapi.Metric)),
+	),
+)
+
+// SetMeasurementSystemToolHandler handles measurement system preference requests
+type SetMeasurementSystemToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewSetMeasurementSystemToolHandler creates a new measurement system preference handler
+func NewSetMeasurementSystemToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *SetMeasurementSystemToolHandler {
+	return &SetMeasurementSystemToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles measurement system preference requests
+func (handler *SetMeasurementSystemToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	requested, err := requireNonEmptyString(request, "measurementSystem")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	measurementSystem := accountsapi.MeasurementSystemModel(strings.ToLower(requested))
+	if measurementSystem != accountsapi.Imperial && measurementSystem != accountsapi.Metric {
+		err := fmt.Errorf("argument \"measurementSystem\" must be one of '%s' or '%s'", accountsapi.Imperial, accountsapi.Metric)
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	if result, err := requireAuthenticated(ctx, handler.authManager, "change your preferences"); result != nil {
+		return result, err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP setting measurement system preference: " + string(measurementSystem))
+
+	// The accounts API replaces all preferences, so the current values are kept for everything else.
+	profile, err := fetchOwnedProfile(ctx, handler.authManager, handler.client)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	preferences := accountsapi.AccountPreferencesModel{}
+	if profile.Preferences != nil {
+		preferences = *profile.Preferences
+	}
+	preferences.MeasurementSystem = measurementSystem
+
+	if _, err := updatePreferences(ctx, handler.authManager, handler.client, preferences); err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Your measurement system preference has been saved as %s.  Ingredient amounts will now be shown in %s units.", measurementSystem, measurementSystem)), nil
+}
+
+// updatePreferences replaces the authenticated user's preferences and returns the updated profile.
+func updatePreferences(ctx context.Context, authManager *auth.OAuthFlowManager, client *accountsapi.Client, preferences accountsapi.AccountPreferencesModel) (*accountsapi.AccountOwnedProfileRs, error) {
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := client.PutV1AccountsOwnedProfilePreferences(callCtx, &accountsapi.PutV1AccountsOwnedProfilePreferencesParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, accountsapi.PutV1AccountsOwnedProfilePreferencesJSONRequestBody{
+		MeasurementSystem:  preferences.MeasurementSystem,
+		ShowRecentSearches: preferences.ShowRecentSearches,
+	}, accountsapi.RequestEditor(authManager))
+
+	return decodeResponse[accountsapi.AccountOwnedProfileRs](ctx, rs, callErr, "updating account preferences")
+}
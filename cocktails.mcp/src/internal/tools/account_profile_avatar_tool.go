@@ -0,0 +1,180 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+// maxAvatarBytes limits the size of an uploaded avatar image.
+const maxAvatarBytes = 5 * 1024 * 1024
+
+// avatarFileExtensions maps the supported avatar image types to the file extension used in the upload.
+var avatarFileExtensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/webp": "webp",
+}
+
+var uploadAvatarDescription = fmt.Sprintf(`This tool uploads a new avatar (profile picture) for your Cezzis.com account and returns the URI of the new avatar.
+
+Supply the image as base64 encoded data in 'imageData', either as plain base64 or as a data URI such as 'data:image/png;base64,...'.  JPEG, PNG
+and WebP images up to %d MB are supported.  The image type is detected from the image data; if 'mimeType' is also supplied it must match.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`, maxAvatarBytes/(1024*1024))
+
+// UploadAvatarTool uploads a new avatar image for the authenticated user
+var UploadAvatarTool = mcp.NewTool(
+	"upload_profile_avatar",
+	mcp.WithDescription(uploadAvatarDescription),
+	mcp.WithString("imageData",
+		mcp.Required(),
+		mcp.Description("The avatar image as base64 encoded data or a base64 data URI."),
+	),
+	mcp.WithString("mimeType",
+		mcp.Description("The MIME type of the image, such as 'image/png'.  Optional; the type is detected from the image data."),
+	),
+)
+
+// UploadAvatarToolHandler handles avatar upload requests
+type UploadAvatarToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewUploadAvatarToolHandler creates a new avatar upload handler
+func NewUploadAvatarToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *UploadAvatarToolHandler {
+	return &UploadAvatarToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles avatar upload requests
+func (handler *UploadAvatarToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	imageData, err := requireNonEmptyString(request, "imageData")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	image, mimeType, err := decodeAvatarImage(imageData, request.GetString("mimeType", ""))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	if result, err := requireAuthenticated(ctx, handler.authManager, "upload an avatar"); result != nil {
+		return result, err
+	}
+
+	telemetry.Logger.Info().
+		Ctx(ctx).
+		Str("mime_type", mimeType).
+		Int("size_bytes", len(image)).
+		Msg("MCP uploading profile avatar")
+
+	contentType, body, err := avatarMultipartBody(image, mimeType)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.PostV1AccountsOwnedProfileImageWithBody(callCtx, &accountsapi.PostV1AccountsOwnedProfileImageParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, contentType, body, accountsapi.RequestEditor(handler.authManager))
+
+	uploadRs, err := decodeResponse[accountsapi.UploadProfileImageRs](ctx, rs, callErr, "uploading profile avatar")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Your avatar has been updated.\n\nAvatar URI: %s", uploadRs.ImageUri)), nil
+}
+
+// decodeAvatarImage decodes the base64 image data, which may be a data URI, and validates its size and type.
+// The type is detected from the image bytes and must agree with the declared MIME type when one is supplied.
+func decodeAvatarImage(imageData string, declaredMimeType string) ([]byte, string, error) {
+	declaredMimeType = strings.ToLower(strings.TrimSpace(declaredMimeType))
+
+	if rest, ok := strings.CutPrefix(imageData, "data:"); ok {
+		header, data, found := strings.Cut(rest, ",")
+		if !found || !strings.HasSuffix(header, ";base64") {
+			return nil, "", errors.New("argument \"imageData\" must be a base64 data URI")
+		}
+
+		if declaredMimeType == "" {
+			declaredMimeType = strings.ToLower(strings.TrimSuffix(header, ";base64"))
+		}
+		imageData = data
+	}
+
+	if base64.StdEncoding.DecodedLen(len(imageData)) > maxAvatarBytes+2 {
+		return nil, "", fmt.Errorf("avatar images must be %d MB or smaller", maxAvatarBytes/(1024*1024))
+	}
+
+	image, err := base64.StdEncoding.DecodeString(imageData)
+	if err != nil {
+		return nil, "", errors.New("argument \"imageData\" is not valid base64 data")
+	}
+
+	if len(image) == 0 {
+		return nil, "", errors.New("argument \"imageData\" contains no image data")
+	}
+
+	if len(image) > maxAvatarBytes {
+		return nil, "", fmt.Errorf("avatar images must be %d MB or smaller", maxAvatarBytes/(1024*1024))
+	}
+
+	mimeType := http.DetectContentType(image)
+	if _, ok := avatarFileExtensions[mimeType]; !ok {
+		return nil, "", fmt.Errorf("unsupported avatar image type %q; JPEG, PNG and WebP images are supported", mimeType)
+	}
+
+	if declaredMimeType != "" && declaredMimeType != mimeType {
+		return nil, "", fmt.Errorf("the image data is %q but the declared type is %q", mimeType, declaredMimeType)
+	}
+
+	return image, mimeType, nil
+}
+
+// avatarMultipartBody builds the multipart/form-data request body expected by the accounts API profile image endpoint.
+func avatarMultipartBody(image []byte, mimeType string) (string, *bytes.Buffer, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="avatar.%s"`, avatarFileExtensions[mimeType]))
+	header.Set("Content-Type", mimeType)
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if _, err := part.Write(image); err != nil {
+		return "", nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", nil, err
+	}
+
+	return writer.FormDataContentType(), body, nil
+}
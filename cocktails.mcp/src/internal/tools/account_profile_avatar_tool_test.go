@@ -0,0 +1,143 @@
+package tools_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+var pngHeader = []byte("\x89PNG\x0D\x0A\x1A\x0A")
+
+func Test_uploadavatar_toolhandler_validates_image(t *testing.T) {
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, _, ctx, _ := testutils.Setup(t)
+
+	png := base64.StdEncoding.EncodeToString(append(pngHeader, 0, 0, 0, 0))
+	tooLarge := base64.StdEncoding.EncodeToString(append(pngHeader, bytes.Repeat([]byte{0}, 5*1024*1024)...))
+
+	tests := []struct {
+		name           string
+		arguments      map[string]interface{}
+		expectedErrMsg string
+	}{
+		{
+			name:           "missing imageData",
+			arguments:      map[string]interface{}{},
+			expectedErrMsg: "required argument \"imageData\" not found",
+		},
+		{
+			name:           "invalid base64",
+			arguments:      map[string]interface{}{"imageData": "not base64!"},
+			expectedErrMsg: "argument \"imageData\" is not valid base64 data",
+		},
+		{
+			name:           "data uri without base64",
+			arguments:      map[string]interface{}{"imageData": "data:image/png," + png},
+			expectedErrMsg: "argument \"imageData\" must be a base64 data URI",
+		},
+		{
+			name:           "unsupported type",
+			arguments:      map[string]interface{}{"imageData": base64.StdEncoding.EncodeToString([]byte("GIF89a......"))},
+			expectedErrMsg: "unsupported avatar image type \"image/gif\"; JPEG, PNG and WebP images are supported",
+		},
+		{
+			name:           "declared type mismatch",
+			arguments:      map[string]interface{}{"imageData": png, "mimeType": "image/jpeg"},
+			expectedErrMsg: "the image data is \"image/png\" but the declared type is \"image/jpeg\"",
+		},
+		{
+			name:           "data uri type mismatch",
+			arguments:      map[string]interface{}{"imageData": "data:image/webp;base64," + png},
+			expectedErrMsg: "the image data is \"image/png\" but the declared type is \"image/webp\"",
+		},
+		{
+			name:           "too large",
+			arguments:      map[string]interface{}{"imageData": tooLarge},
+			expectedErrMsg: "avatar images must be 5 MB or smaller",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			request := mcp.CallToolRequest{
+				Request: mcp.Request{
+					Method: "upload_profile_avatar",
+				},
+				Params: mcp.CallToolParams{
+					Name:      "upload_profile_avatar",
+					Arguments: tt.arguments,
+				},
+			}
+
+			handler := tools.NewUploadAvatarToolHandler(nil, nil)
+
+			// act
+			result, err := handler.Handle(ctx, request)
+
+			// assert
+			testutils.AssertError(t, result, err, tt.expectedErrMsg)
+		})
+	}
+}
+
+func Test_uploadavatar_toolhandler_uploads_multipart_image(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	image := append(bytes.Clone(pngHeader), 1, 2, 3, 4)
+
+	var (
+		filename    string
+		contentType string
+		uploaded    []byte
+	)
+	mux.HandleFunc("POST /api/v1/accounts/owned/profile/image", func(w http.ResponseWriter, r *http.Request) {
+		reader, err := r.MultipartReader()
+		require.NoError(t, err)
+
+		part, err := reader.NextPart()
+		require.NoError(t, err)
+		require.Equal(t, "file", part.FormName())
+
+		filename = part.FileName()
+		contentType = part.Header.Get("Content-Type")
+		uploaded, err = io.ReadAll(part)
+		require.NoError(t, err)
+
+		_, err = reader.NextPart()
+		require.ErrorIs(t, err, io.EOF)
+
+		fmt.Fprint(w, `{"imageUri":"https://cdn.cezzis.com/avatars/user-1.png"}`)
+	})
+
+	handler := tools.NewUploadAvatarToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	result, err := handler.Handle(ctx, mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      "upload_profile_avatar",
+			Arguments: map[string]interface{}{"imageData": "data:image/png;base64," + base64.StdEncoding.EncodeToString(image)},
+		},
+	})
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Equal(t, "avatar.png", filename)
+	require.Equal(t, "image/png", contentType)
+	require.Equal(t, image, uploaded)
+	require.Equal(t, "Your avatar has been updated.\n\nAvatar URI: https://cdn.cezzis.com/avatars/user-1.png", result.Content[0].(mcp.TextContent).Text)
+}
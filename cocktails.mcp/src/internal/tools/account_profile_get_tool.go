@@ -0,0 +1,99 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var getProfileDescription = `This tool returns who you are logged in as on Cezzis.com along with a summary of your account profile.  The summary includes
+your username, display name, given and family names, email addresses, avatar, primary address, number of favorite cocktails, preferences and
+notification settings.  Use the 'update_my_profile' tool to change any of these details.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// GetProfileTool returns a summary of the authenticated user's account profile
+var GetProfileTool = mcp.NewTool(
+	"get_my_profile",
+	mcp.WithDescription(getProfileDescription),
+)
+
+// ProfileSummary is a summarized view of the authenticated user's account profile.
+type ProfileSummary struct {
+	Username              string                                        `json:"username,omitempty"`
+	DisplayName           string                                        `json:"displayName,omitempty"`
+	GivenName             string                                        `json:"givenName,omitempty"`
+	FamilyName            string                                        `json:"familyName,omitempty"`
+	Email                 string                                        `json:"email"`
+	LoginEmail            string                                        `json:"loginEmail"`
+	AvatarURI             string                                        `json:"avatarUri,omitempty"`
+	PrimaryAddress        *accountsapi.AccountAddressModel              `json:"primaryAddress,omitempty"`
+	FavoriteCocktailCount int                                           `json:"favoriteCocktailCount"`
+	Preferences           *accountsapi.AccountPreferencesModel          `json:"preferences,omitempty"`
+	Notifications         *accountsapi.AccountNotificationSettingsModel `json:"notifications,omitempty"`
+}
+
+// newProfileSummary creates a ProfileSummary from the accounts API profile response.
+func newProfileSummary(profile *accountsapi.AccountOwnedProfileRs) ProfileSummary {
+	summary := ProfileSummary{
+		Username:       stringValue(profile.Username),
+		DisplayName:    stringValue(profile.DisplayName),
+		GivenName:      stringValue(profile.GivenName),
+		FamilyName:     stringValue(profile.FamilyName),
+		Email:          profile.Email,
+		LoginEmail:     profile.LoginEmail,
+		AvatarURI:      stringValue(profile.AvatarUri),
+		PrimaryAddress: profile.PrimaryAddress,
+		Preferences:    profile.Preferences,
+		Notifications:  profile.Notifications,
+	}
+
+	if profile.FavoriteCocktails != nil {
+		summary.FavoriteCocktailCount = len(*profile.FavoriteCocktails)
+	}
+
+	return summary
+}
+
+// GetProfileToolHandler handles account profile requests
+type GetProfileToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewGetProfileToolHandler creates a new account profile handler
+func NewGetProfileToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *GetProfileToolHandler {
+	return &GetProfileToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles account profile requests
+func (handler *GetProfileToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if result, err := requireAuthenticated(ctx, handler.authManager, "view your profile"); result != nil {
+		return result, err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP getting account profile")
+
+	profile, err := fetchOwnedProfile(ctx, handler.authManager, handler.client)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	jsonBytes, err := json.Marshal(newProfileSummary(profile))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
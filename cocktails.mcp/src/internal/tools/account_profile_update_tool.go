@@ -0,0 +1,299 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+// profileAccountArguments are the update_my_profile arguments applied through their own account endpoints.
+var profileAccountArguments = []string{"username", "email"}
+
+// profileNameArguments are the update_my_profile arguments applied through the profile update endpoint.
+var profileNameArguments = []string{"displayName", "givenName", "familyName"}
+
+// profileAddressArguments are the update_my_profile arguments that make up the primary address.
+var profileAddressArguments = []string{"addressLine1", "addressLine2", "city", "region", "subRegion", "postalCode", "country"}
+
+var updateProfileDescription = `This tool updates your Cezzis.com account profile.  Only the values that are supplied are changed; everything else
+is left as it is.  You can change your display name, given and family names, username, email address and any part of your primary address.
+Changing your username or email address may fail if it is already in use by another account.  When some values cannot be saved the others
+are still saved, and the error lists the values that were updated and those that failed.
+
+Always confirm the new values with the user before updating their profile.  The updated profile summary is returned, the same as the
+'get_my_profile' tool.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// UpdateProfileTool applies partial updates to the authenticated user's account profile
+var UpdateProfileTool = mcp.NewTool(
+	"update_my_profile",
+	mcp.WithDescription(updateProfileDescription),
+	mcp.WithString("displayName", mcp.Description("The name shown to other Cezzis.com users.")),
+	mcp.WithString("givenName", mcp.Description("Your given (first) name.")),
+	mcp.WithString("familyName", mcp.Description("Your family (last) name.")),
+	mcp.WithString("username", mcp.Description("A new username for your account.")),
+	mcp.WithString("email", mcp.Description("A new email address for your account.")),
+	mcp.WithString("addressLine1", mcp.Description("The primary street address of your primary address.")),
+	mcp.WithString("addressLine2", mcp.Description("The secondary street address, such as an apartment or suite, of your primary address.")),
+	mcp.WithString("city", mcp.Description("The city of your primary address.")),
+	mcp.WithString("region", mcp.Description("The state or province of your primary address.")),
+	mcp.WithString("subRegion", mcp.Description("The county or other state or province divider of your primary address.")),
+	mcp.WithString("postalCode", mcp.Description("The postal or zip code of your primary address.")),
+	mcp.WithString("country", mcp.Description("The country of your primary address.")),
+)
+
+// UpdateProfileToolHandler handles account profile update requests
+type UpdateProfileToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewUpdateProfileToolHandler creates a new account profile update handler
+func NewUpdateProfileToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *UpdateProfileToolHandler {
+	return &UpdateProfileToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles account profile update requests
+func (handler *UpdateProfileToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	updates := suppliedStrings(request, slices.Concat(profileAccountArguments, profileNameArguments, profileAddressArguments))
+	if len(updates) == 0 {
+		err := errors.New("at least one profile value must be supplied")
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	for _, key := range slices.Concat(profileAccountArguments, profileNameArguments) {
+		if value, ok := updates[key]; ok && value == "" {
+			err := fmt.Errorf("argument %q cannot be empty", key)
+			return mcp.NewToolResultError(err.Error()), err
+		}
+	}
+
+	if email, ok := updates["email"]; ok {
+		if _, err := validateEmail(email); err != nil {
+			return mcp.NewToolResultError(err.Error()), err
+		}
+	}
+
+	if result, err := requireAuthenticated(ctx, handler.authManager, "update your profile"); result != nil {
+		return result, err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Strs("fields", slices.Sorted(maps.Keys(updates))).Msg("MCP updating account profile")
+
+	profile, err := fetchOwnedProfile(ctx, handler.authManager, handler.client)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	// The values are saved through separate accounts API calls.  Every call is attempted so one failure, such as a
+	// username already in use, does not stop the other values from being saved, and the result reports which
+	// values were saved and which failed.
+	steps := handler.updateSteps(profile, updates)
+	applied := []string{}
+	failures := []string{}
+	var failed error
+
+	for _, step := range steps {
+		updated, err := step.apply(ctx)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s (%v)", strings.Join(step.fields, ", "), err))
+			failed = err
+			continue
+		}
+
+		profile = updated
+		applied = append(applied, step.fields...)
+	}
+
+	if len(failures) > 0 {
+		err := failed
+		if len(failures) > 1 || len(applied) > 0 {
+			err = profileUpdateError(applied, failures)
+		}
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	jsonBytes, err := json.Marshal(newProfileSummary(profile))
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
+
+// profileUpdateStep is one of the accounts API calls made to update the profile along with the arguments it saves.
+type profileUpdateStep struct {
+	fields []string
+	apply  func(ctx context.Context) (*accountsapi.AccountOwnedProfileRs, error)
+}
+
+// updateSteps returns the accounts API calls needed to save the supplied values that differ from the profile, in
+// the order they are made.
+func (handler *UpdateProfileToolHandler) updateSteps(profile *accountsapi.AccountOwnedProfileRs, updates map[string]string) []profileUpdateStep {
+	steps := []profileUpdateStep{}
+
+	if fields := suppliedKeys(updates, slices.Concat(profileNameArguments, profileAddressArguments)); len(fields) > 0 {
+		steps = append(steps, profileUpdateStep{
+			fields: fields,
+			apply: func(ctx context.Context) (*accountsapi.AccountOwnedProfileRs, error) {
+				return handler.updateProfile(ctx, profile, updates)
+			},
+		})
+	}
+
+	if username, ok := updates["username"]; ok && username != stringValue(profile.Username) {
+		steps = append(steps, profileUpdateStep{
+			fields: []string{"username"},
+			apply: func(ctx context.Context) (*accountsapi.AccountOwnedProfileRs, error) {
+				return handler.updateUsername(ctx, username)
+			},
+		})
+	}
+
+	if email, ok := updates["email"]; ok && !strings.EqualFold(email, profile.Email) {
+		steps = append(steps, profileUpdateStep{
+			fields: []string{"email"},
+			apply: func(ctx context.Context) (*accountsapi.AccountOwnedProfileRs, error) {
+				return handler.updateEmail(ctx, email)
+			},
+		})
+	}
+
+	return steps
+}
+
+// profileUpdateError reports the values that were saved and the calls that failed when updating the profile.
+func profileUpdateError(applied []string, failures []string) error {
+	if len(applied) == 0 {
+		return fmt.Errorf("the profile was not updated; failed: %s", strings.Join(failures, "; "))
+	}
+
+	return fmt.Errorf("the profile was only partly updated; updated: %s; failed: %s", strings.Join(applied, ", "), strings.Join(failures, "; "))
+}
+
+// updateProfile applies the supplied name and address values on top of the current profile.  The accounts API
+// replaces these values, so the current values are sent for anything that is not being changed.
+func (handler *UpdateProfileToolHandler) updateProfile(ctx context.Context, profile *accountsapi.AccountOwnedProfileRs, updates map[string]string) (*accountsapi.AccountOwnedProfileRs, error) {
+	body := accountsapi.PutV1AccountsOwnedProfileJSONRequestBody{
+		DisplayName:    valueOr(updates, "displayName", stringValue(profile.DisplayName)),
+		GivenName:      valueOr(updates, "givenName", stringValue(profile.GivenName)),
+		FamilyName:     valueOr(updates, "familyName", stringValue(profile.FamilyName)),
+		PrimaryAddress: profile.PrimaryAddress,
+	}
+
+	if anySupplied(updates, profileAddressArguments) {
+		address := accountsapi.AccountAddressModel{}
+		if profile.PrimaryAddress != nil {
+			address = *profile.PrimaryAddress
+		}
+
+		address.AddressLine1 = valueOr(updates, "addressLine1", address.AddressLine1)
+		address.AddressLine2 = valueOr(updates, "addressLine2", address.AddressLine2)
+		address.City = valueOr(updates, "city", address.City)
+		address.Region = valueOr(updates, "region", address.Region)
+		address.SubRegion = valueOr(updates, "subRegion", address.SubRegion)
+		address.PostalCode = valueOr(updates, "postalCode", address.PostalCode)
+		address.Country = valueOr(updates, "country", address.Country)
+		body.PrimaryAddress = &address
+	}
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.PutV1AccountsOwnedProfile(callCtx, &accountsapi.PutV1AccountsOwnedProfileParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, body, accountsapi.RequestEditor(handler.authManager))
+
+	return decodeResponse[accountsapi.AccountOwnedProfileRs](ctx, rs, callErr, "updating account profile")
+}
+
+// updateUsername changes the username of the account.
+func (handler *UpdateProfileToolHandler) updateUsername(ctx context.Context, username string) (*accountsapi.AccountOwnedProfileRs, error) {
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.PutV1AccountsOwnedProfileUsername(callCtx, &accountsapi.PutV1AccountsOwnedProfileUsernameParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, accountsapi.PutV1AccountsOwnedProfileUsernameJSONRequestBody{
+		Username: username,
+	}, accountsapi.RequestEditor(handler.authManager))
+
+	return decodeResponse[accountsapi.AccountOwnedProfileRs](ctx, rs, callErr, "changing account username")
+}
+
+// updateEmail changes the email address of the account.
+func (handler *UpdateProfileToolHandler) updateEmail(ctx context.Context, email string) (*accountsapi.AccountOwnedProfileRs, error) {
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.PutV1AccountsOwnedProfileEmail(callCtx, &accountsapi.PutV1AccountsOwnedProfileEmailParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, accountsapi.PutV1AccountsOwnedProfileEmailJSONRequestBody{
+		Email: email,
+	}, accountsapi.RequestEditor(handler.authManager))
+
+	return decodeResponse[accountsapi.AccountOwnedProfileRs](ctx, rs, callErr, "changing account email")
+}
+
+// suppliedStrings returns the trimmed values of the string arguments in keys that were present in the request.
+func suppliedStrings(request mcp.CallToolRequest, keys []string) map[string]string {
+	args := request.GetArguments()
+	supplied := map[string]string{}
+	for _, key := range keys {
+		if _, ok := args[key]; ok {
+			supplied[key] = strings.TrimSpace(request.GetString(key, ""))
+		}
+	}
+
+	return supplied
+}
+
+// suppliedKeys returns the keys that are present in the supplied values, in the order of keys.
+func suppliedKeys(supplied map[string]string, keys []string) []string {
+	present := []string{}
+	for _, key := range keys {
+		if _, ok := supplied[key]; ok {
+			present = append(present, key)
+		}
+	}
+
+	return present
+}
+
+// anySupplied reports whether any of the keys are present in the supplied values.
+func anySupplied(supplied map[string]string, keys []string) bool {
+	for _, key := range keys {
+		if _, ok := supplied[key]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// valueOr returns the supplied value for the key, or fallback when it was not supplied.
+func valueOr(supplied map[string]string, key string, fallback string) string {
+	if value, ok := supplied[key]; ok {
+		return value
+	}
+
+	return fallback
+}
@@ -0,0 +1,154 @@
+package tools_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func Test_updateprofile_toolhandler_validates_arguments(t *testing.T) {
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, _, ctx, _ := testutils.Setup(t)
+
+	tests := []struct {
+		name           string
+		arguments      map[string]interface{}
+		expectedErrMsg string
+	}{
+		{
+			name:           "no values supplied",
+			arguments:      map[string]interface{}{},
+			expectedErrMsg: "at least one profile value must be supplied",
+		},
+		{
+			name:           "empty username",
+			arguments:      map[string]interface{}{"username": "  "},
+			expectedErrMsg: "argument \"username\" cannot be empty",
+		},
+		{
+			name:           "empty displayName",
+			arguments:      map[string]interface{}{"displayName": "", "city": "Boston"},
+			expectedErrMsg: "argument \"displayName\" cannot be empty",
+		},
+		{
+			name:           "invalid email",
+			arguments:      map[string]interface{}{"email": "someone@"},
+			expectedErrMsg: "\"someone@\" is not a valid email address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			request := mcp.CallToolRequest{
+				Request: mcp.Request{
+					Method: "update_my_profile",
+				},
+				Params: mcp.CallToolParams{
+					Name:      "update_my_profile",
+					Arguments: tt.arguments,
+				},
+			}
+
+			handler := tools.NewUpdateProfileToolHandler(nil, nil)
+
+			// act
+			result, err := handler.Handle(ctx, request)
+
+			// assert
+			testutils.AssertError(t, result, err, tt.expectedErrMsg)
+		})
+	}
+}
+
+// profileUpdateServer serves the account profile and records the accounts API update calls made.
+type profileUpdateServer struct {
+	calls          []string
+	usernameStatus int
+}
+
+func (server *profileUpdateServer) register(mux *http.ServeMux) {
+	profile := `{"subjectId":"user-1","displayName":%q,"username":%q,"email":%q}`
+
+	mux.HandleFunc("/api/v1/accounts/owned/profile", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			server.calls = append(server.calls, "profile")
+			fmt.Fprintf(w, profile, "Bar Keep", "old", "old@example.com")
+			return
+		}
+		fmt.Fprintf(w, profile, "Old Name", "old", "old@example.com")
+	})
+	mux.HandleFunc("/api/v1/accounts/owned/profile/username", func(w http.ResponseWriter, r *http.Request) {
+		server.calls = append(server.calls, "username")
+		if server.usernameStatus != 0 {
+			http.Error(w, "username is taken", server.usernameStatus)
+			return
+		}
+		fmt.Fprintf(w, profile, "Bar Keep", "barkeep", "old@example.com")
+	})
+	mux.HandleFunc("/api/v1/accounts/owned/profile/email", func(w http.ResponseWriter, r *http.Request) {
+		server.calls = append(server.calls, "email")
+		fmt.Fprintf(w, profile, "Bar Keep", "old", "new@example.com")
+	})
+}
+
+func updateProfileRequest() mcp.CallToolRequest {
+	return mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name: "update_my_profile",
+			Arguments: map[string]interface{}{
+				"displayName": "Bar Keep",
+				"username":    "barkeep",
+				"email":       "new@example.com",
+			},
+		},
+	}
+}
+
+func Test_updateprofile_toolhandler_saves_every_value(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	server := &profileUpdateServer{}
+	server.register(mux)
+
+	handler := tools.NewUpdateProfileToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	result, err := handler.Handle(ctx, updateProfileRequest())
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Equal(t, []string{"profile", "username", "email"}, server.calls)
+	require.Contains(t, result.Content[0].(mcp.TextContent).Text, `"email":"new@example.com"`)
+}
+
+func Test_updateprofile_toolhandler_reports_values_saved_when_a_later_update_fails(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	server := &profileUpdateServer{usernameStatus: http.StatusConflict}
+	server.register(mux)
+
+	handler := tools.NewUpdateProfileToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	result, err := handler.Handle(ctx, updateProfileRequest())
+
+	// assert
+	testutils.AssertError(t, result, err, "the profile was only partly updated; updated: displayName, email; "+
+		"failed: username (changing account username failed (status 409): username is taken)")
+	require.Equal(t, []string{"profile", "username", "email"}, server.calls)
+}
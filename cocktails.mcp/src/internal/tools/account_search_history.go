@@ -0,0 +1,237 @@
+package tools
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/middleware"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+// maxSearchHistoryItems limits the number of recent searches kept in an account's search history.
+const maxSearchHistoryItems = 25
+
+const (
+	// searchHistoryWorkers is the number of searches recorded at the same time.
+	searchHistoryWorkers = 4
+	// maxPendingSearchHistory limits the number of searches waiting to be recorded.  Searches made while the queue
+	// is full are dropped rather than holding up the search results.
+	maxPendingSearchHistory = 100
+)
+
+// pendingSearch is a search waiting to be recorded in the search history.
+type pendingSearch struct {
+	ctx  context.Context
+	term string
+}
+
+// searchHistoryRecorder merges search terms into the search history of authenticated users so that
+// searches made through MCP show up as recent searches on Cezzis.com.  Searches are recorded in the background
+// by a fixed number of workers, and the history of each user is updated by one worker at a time so concurrent
+// searches are not lost.
+type searchHistoryRecorder struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+	pending     chan pendingSearch
+	start       sync.Once
+	locks       keyedMutex
+}
+
+// newSearchHistoryRecorder creates a recorder that updates search history through the accounts API.  The workers
+// are started with the first search that is queued.
+func newSearchHistoryRecorder(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *searchHistoryRecorder {
+	return &searchHistoryRecorder{
+		authManager: authManager,
+		client:      client,
+		pending:     make(chan pendingSearch, maxPendingSearchHistory),
+	}
+}
+
+// enqueue queues the term to be recorded in the background, detached from the request's cancellation so it never
+// delays the search results.  The term is dropped when the queue is full.
+func (recorder *searchHistoryRecorder) enqueue(ctx context.Context, term string) {
+	if recorder == nil || recorder.authManager == nil || recorder.client == nil || strings.TrimSpace(term) == "" {
+		return
+	}
+
+	recorder.start.Do(func() {
+		for range searchHistoryWorkers {
+			go recorder.work()
+		}
+	})
+
+	select {
+	case recorder.pending <- pendingSearch{ctx: context.WithoutCancel(ctx), term: term}:
+	default:
+		telemetry.Logger.Warn().Ctx(ctx).Msg("MCP Warning: search history queue is full, the search was not recorded")
+	}
+}
+
+// work records queued searches for the life of the process.
+func (recorder *searchHistoryRecorder) work() {
+	for search := range recorder.pending {
+		recorder.record(search.ctx, search.term)
+	}
+}
+
+// record merges the term into the authenticated user's search history.  Nothing is recorded when the
+// session is not authenticated or the user has turned off recent searches in their preferences.  Failures
+// are logged rather than returned because recording history must never fail the search itself.
+func (recorder *searchHistoryRecorder) record(ctx context.Context, term string) {
+	term = strings.TrimSpace(term)
+	if term == "" || recorder.authManager == nil || recorder.client == nil {
+		return
+	}
+
+	sessionID, ok := ctx.Value(middleware.McpSessionIDKey).(string)
+	if !ok || sessionID == "" || !recorder.authManager.IsAuthenticated(ctx, sessionID) {
+		return
+	}
+
+	profile, err := fetchOwnedProfile(ctx, recorder.authManager, recorder.client)
+	if err != nil {
+		telemetry.Logger.Warn().Ctx(ctx).Err(err).Msg("MCP Warning: failed to get preferences when recording search history")
+		return
+	}
+
+	if profile.Preferences != nil && profile.Preferences.ShowRecentSearches != nil && !*profile.Preferences.ShowRecentSearches {
+		return
+	}
+
+	// The accounts API only replaces the whole history, so the read, merge and replace of one user's history
+	// must not interleave with another search by the same user.
+	unlock := recorder.locks.lock(profile.SubjectId)
+	defer unlock()
+
+	items, err := fetchSearchHistory(ctx, recorder.authManager, recorder.client)
+	if err != nil {
+		telemetry.Logger.Warn().Ctx(ctx).Err(err).Msg("MCP Warning: failed to get search history")
+		return
+	}
+
+	items = mergeSearchHistory(items, term, time.Now())
+
+	if _, err := replaceSearchHistory(ctx, recorder.authManager, recorder.client, items); err != nil {
+		telemetry.Logger.Warn().Ctx(ctx).Err(err).Msg("MCP Warning: failed to update search history")
+	}
+}
+
+// keyedMutex provides a mutex for each key, such as a user's subject id.  A key's mutex is discarded once nobody
+// holds or waits for it.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+// keyedLock is the mutex of one key along with the number of callers holding or waiting for it.
+type keyedLock struct {
+	sync.Mutex
+	refs int
+}
+
+// lock locks the key's mutex and returns the function that unlocks it.
+func (km *keyedMutex) lock(key string) func() {
+	km.mu.Lock()
+	if km.locks == nil {
+		km.locks = map[string]*keyedLock{}
+	}
+	entry, ok := km.locks[key]
+	if !ok {
+		entry = &keyedLock{}
+		km.locks[key] = entry
+	}
+	entry.refs++
+	km.mu.Unlock()
+
+	entry.Lock()
+
+	return func() {
+		entry.Unlock()
+
+		km.mu.Lock()
+		entry.refs--
+		if entry.refs == 0 {
+			delete(km.locks, key)
+		}
+		km.mu.Unlock()
+	}
+}
+
+// mergeSearchHistory records a search for term at the supplied time.  A term that was searched before, ignoring case,
+// has its count incremented and takes the casing of the latest search.  The merged history is ordered from the most
+// recent search.  The accounts API does not document a limit on the history, so only the growth this server causes is
+// capped: a new term is appended while the history holds fewer than maxSearchHistoryItems and otherwise replaces the
+// oldest entry, leaving any longer history written by other clients at its existing length.
+func mergeSearchHistory(items []accountsapi.SearchHistoryItemModel, term string, searchedAt time.Time) []accountsapi.SearchHistoryItemModel {
+	merged := make([]accountsapi.SearchHistoryItemModel, 0, len(items)+1)
+	current := accountsapi.SearchHistoryItemModel{
+		Count:     1,
+		Term:      term,
+		Timestamp: int(searchedAt.UnixMilli()),
+	}
+
+	for _, item := range items {
+		if strings.EqualFold(item.Term, term) {
+			current.Count += item.Count
+			continue
+		}
+		merged = append(merged, item)
+	}
+
+	merged = append(merged, current)
+	sortSearchHistory(merged)
+
+	if limit := max(len(items), maxSearchHistoryItems); len(merged) > limit {
+		merged = merged[:limit]
+	}
+
+	return merged
+}
+
+// sortSearchHistory orders the search history from the most recent search.
+func sortSearchHistory(items []accountsapi.SearchHistoryItemModel) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Timestamp > items[j].Timestamp
+	})
+}
+
+// fetchSearchHistory retrieves the authenticated user's search history from the accounts API.
+func fetchSearchHistory(ctx context.Context, authManager *auth.OAuthFlowManager, client *accountsapi.Client) ([]accountsapi.SearchHistoryItemModel, error) {
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := client.GetV1AccountsOwnedProfileSearchHistory(callCtx, &accountsapi.GetV1AccountsOwnedProfileSearchHistoryParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, accountsapi.RequestEditor(authManager))
+
+	historyRs, err := decodeResponse[accountsapi.SearchHistoryRs](ctx, rs, callErr, "getting search history")
+	if err != nil {
+		return nil, err
+	}
+
+	if historyRs.Items == nil {
+		return []accountsapi.SearchHistoryItemModel{}, nil
+	}
+
+	return *historyRs.Items, nil
+}
+
+// replaceSearchHistory replaces the authenticated user's search history with the supplied items.
+func replaceSearchHistory(ctx context.Context, authManager *auth.OAuthFlowManager, client *accountsapi.Client, items []accountsapi.SearchHistoryItemModel) (*accountsapi.SearchHistoryRs, error) {
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := client.PutV1AccountsOwnedProfileSearchHistory(callCtx, &accountsapi.PutV1AccountsOwnedProfileSearchHistoryParams{
+		XKey: config.GetAppSettings().AccountsAPISubscriptionKey,
+	}, accountsapi.PutV1AccountsOwnedProfileSearchHistoryJSONRequestBody{
+		Items: items,
+	}, accountsapi.RequestEditor(authManager))
+
+	return decodeResponse[accountsapi.SearchHistoryRs](ctx, rs, callErr, "updating search history")
+}
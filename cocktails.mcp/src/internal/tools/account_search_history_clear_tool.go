@@ -0,0 +1,55 @@
+package tools
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var clearRecentSearchesDescription = `This tool clears all of the recent cocktail searches saved to your Cezzis.com account.  Always confirm with the
+user before clearing their recent searches.  To stop new searches from being saved, turn off recent searches in your Cezzis.com preferences.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// ClearRecentSearchesTool clears the authenticated user's recent searches
+var ClearRecentSearchesTool = mcp.NewTool(
+	"clear_recent_searches",
+	mcp.WithDescription(clearRecentSearchesDescription),
+	mcp.WithDestructiveHintAnnotation(true),
+)
+
+// ClearRecentSearchesToolHandler handles recent search clearing requests
+type ClearRecentSearchesToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewClearRecentSearchesToolHandler creates a new recent search clearing handler
+func NewClearRecentSearchesToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *ClearRecentSearchesToolHandler {
+	return &ClearRecentSearchesToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles recent search clearing requests
+func (handler *ClearRecentSearchesToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if result, err := requireAuthenticated(ctx, handler.authManager, "clear your recent searches"); result != nil {
+		return result, err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP clearing recent searches")
+
+	if _, err := replaceSearchHistory(ctx, handler.authManager, handler.client, []accountsapi.SearchHistoryItemModel{}); err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText("Your recent searches have been cleared."), nil
+}
@@ -0,0 +1,100 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+const defaultRecentSearchesTake = 10
+
+var listRecentSearchesDescription = `This tool lists the recent cocktail searches saved to your Cezzis.com account, most recent first.  Each search is
+returned with the search term, the number of times it has been searched and when it was last searched.  Searches made with the
+'search_cocktails' tool while authenticated are included unless recent searches are turned off in your preferences.
+
+Use the search terms with the 'search_cocktails' tool to repeat a search.  Use the 'clear_recent_searches' tool to clear them.
+
+You must be authenticated using the 'authentication_login_flow' tool prior to using this feature. Furthermore, You must have a valid and active
+mcp session, the session identifier from the original initialization request must be present in the request to this tool via the Mcp-Session-Id header.
+If the response returns an error about authentication, please run the 'authentication_login_flow' tool first.
+`
+
+// ListRecentSearchesTool lists the authenticated user's recent searches
+var ListRecentSearchesTool = mcp.NewTool(
+	"list_recent_searches",
+	mcp.WithDescription(listRecentSearchesDescription),
+	mcp.WithNumber("take",
+		mcp.Description(fmt.Sprintf("The number of recent searches to return.  Defaults to %d, maximum of %d.", defaultRecentSearchesTake, maxSearchHistoryItems)),
+		mcp.Min(1),
+		mcp.Max(maxSearchHistoryItems),
+	),
+)
+
+// RecentSearch is a single entry in the authenticated user's search history.
+type RecentSearch struct {
+	Term           string    `json:"term"`
+	Count          int       `json:"count"`
+	LastSearchedAt time.Time `json:"lastSearchedAt"`
+}
+
+// ListRecentSearchesToolHandler handles recent search listing requests
+type ListRecentSearchesToolHandler struct {
+	authManager *auth.OAuthFlowManager
+	client      *accountsapi.Client
+}
+
+// NewListRecentSearchesToolHandler creates a new recent search listing handler
+func NewListRecentSearchesToolHandler(authManager *auth.OAuthFlowManager, client *accountsapi.Client) *ListRecentSearchesToolHandler {
+	return &ListRecentSearchesToolHandler{
+		authManager: authManager,
+		client:      client,
+	}
+}
+
+// Handle handles recent search listing requests
+func (handler *ListRecentSearchesToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	take := request.GetInt("take", defaultRecentSearchesTake)
+	if take < 1 || take > maxSearchHistoryItems {
+		err := fmt.Errorf("argument \"take\" must be between 1 and %d", maxSearchHistoryItems)
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	if result, err := requireAuthenticated(ctx, handler.authManager, "list your recent searches"); result != nil {
+		return result, err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Int("take", take).Msg("MCP listing recent searches")
+
+	items, err := fetchSearchHistory(ctx, handler.authManager, handler.client)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	sortSearchHistory(items)
+	if len(items) > take {
+		items = items[:take]
+	}
+
+	searches := make([]RecentSearch, 0, len(items))
+	for _, item := range items {
+		searches = append(searches, RecentSearch{
+			Term:           item.Term,
+			Count:          item.Count,
+			LastSearchedAt: time.UnixMilli(int64(item.Timestamp)).UTC(),
+		})
+	}
+
+	jsonBytes, err := json.Marshal(searches)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
@@ -0,0 +1,38 @@
+package tools_test
+
+import (
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func Test_listrecentsearches_toolhandler_returns_error_on_invalid_take(t *testing.T) {
+	for _, take := range []int{0, 26} {
+		// arrange
+		testutils.LoadEnvironment("..", "..")
+		_, _, _, ctx, _ := testutils.Setup(t)
+
+		request := mcp.CallToolRequest{
+			Request: mcp.Request{
+				Method: "list_recent_searches",
+			},
+			Params: mcp.CallToolParams{
+				Name: "list_recent_searches",
+				Arguments: map[string]interface{}{
+					"take": take,
+				},
+			},
+		}
+
+		handler := tools.NewListRecentSearchesToolHandler(nil, nil)
+
+		// act
+		result, err := handler.Handle(ctx, request)
+
+		// assert
+		testutils.AssertError(t, result, err, "argument \"take\" must be between 1 and 25")
+	}
+}
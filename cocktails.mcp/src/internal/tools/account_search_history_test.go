@@ -0,0 +1,198 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+// searchHistoryServer serves the profile and search history endpoints of the accounts API from memory.
+type searchHistoryServer struct {
+	mu                 sync.Mutex
+	showRecentSearches bool
+	items              []accountsapi.SearchHistoryItemModel
+	puts               int
+}
+
+func (server *searchHistoryServer) register(mux *http.ServeMux) {
+	mux.HandleFunc("/api/v1/accounts/owned/profile", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"subjectId":"user-1","preferences":{"measurementSystem":"imperial","showRecentSearches":%t}}`, server.showRecentSearches)
+	})
+	mux.HandleFunc("/api/v1/accounts/owned/profile/search-history", func(w http.ResponseWriter, r *http.Request) {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+
+		if r.Method == http.MethodPut {
+			var body accountsapi.PutV1AccountsOwnedProfileSearchHistoryJSONRequestBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			server.items = body.Items
+			server.puts++
+		}
+
+		_ = json.NewEncoder(w).Encode(accountsapi.SearchHistoryRs{Items: &server.items})
+	})
+}
+
+func Test_merge_search_history(t *testing.T) {
+	t.Parallel()
+
+	now := time.UnixMilli(1_000_000)
+	item := func(term string, count int, timestamp int) accountsapi.SearchHistoryItemModel {
+		return accountsapi.SearchHistoryItemModel{Term: term, Count: count, Timestamp: timestamp}
+	}
+
+	tests := []struct {
+		name     string
+		items    []accountsapi.SearchHistoryItemModel
+		term     string
+		expected []accountsapi.SearchHistoryItemModel
+	}{
+		{
+			name:     "new term",
+			items:    []accountsapi.SearchHistoryItemModel{item("gin", 2, 500)},
+			term:     "rum",
+			expected: []accountsapi.SearchHistoryItemModel{item("rum", 1, 1_000_000), item("gin", 2, 500)},
+		},
+		{
+			name:     "repeated term ignoring case takes the latest casing and adds to the count",
+			items:    []accountsapi.SearchHistoryItemModel{item("gin", 1, 900), item("Mezcal", 3, 500)},
+			term:     "mezcal",
+			expected: []accountsapi.SearchHistoryItemModel{item("mezcal", 4, 1_000_000), item("gin", 1, 900)},
+		},
+		{
+			name:     "orders from the most recent search",
+			items:    []accountsapi.SearchHistoryItemModel{item("old", 1, 100), item("newer", 1, 700), item("middle", 1, 400)},
+			term:     "latest",
+			expected: []accountsapi.SearchHistoryItemModel{item("latest", 1, 1_000_000), item("newer", 1, 700), item("middle", 1, 400), item("old", 1, 100)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, tools.MergeSearchHistory(test.items, test.term, now))
+		})
+	}
+}
+
+func Test_merge_search_history_caps_the_history(t *testing.T) {
+	t.Parallel()
+
+	items := []accountsapi.SearchHistoryItemModel{}
+	for i := range 25 {
+		items = append(items, accountsapi.SearchHistoryItemModel{Term: fmt.Sprintf("term %d", i), Count: 1, Timestamp: 1000 - i})
+	}
+
+	// act
+	merged := tools.MergeSearchHistory(items, "negroni", time.UnixMilli(2000))
+
+	// assert
+	require.Len(t, merged, 25)
+	require.Equal(t, "negroni", merged[0].Term)
+	require.Equal(t, "term 23", merged[24].Term)
+}
+
+func Test_merge_search_history_keeps_a_longer_upstream_history(t *testing.T) {
+	t.Parallel()
+
+	items := []accountsapi.SearchHistoryItemModel{}
+	for i := range 40 {
+		items = append(items, accountsapi.SearchHistoryItemModel{Term: fmt.Sprintf("term %d", i), Count: 1, Timestamp: 1000 - i})
+	}
+
+	// act
+	repeated := tools.MergeSearchHistory(items, "term 39", time.UnixMilli(2000))
+	added := tools.MergeSearchHistory(items, "negroni", time.UnixMilli(2000))
+
+	// assert
+	require.Len(t, repeated, 40)
+	require.Equal(t, "term 39", repeated[0].Term)
+	require.Len(t, added, 40)
+	require.Equal(t, "negroni", added[0].Term)
+	require.Equal(t, "term 38", added[39].Term)
+}
+
+func Test_search_history_recorder_skips_users_who_turned_off_recent_searches(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	server := &searchHistoryServer{showRecentSearches: false}
+	server.register(mux)
+
+	recorder := tools.NewSearchHistoryRecorder(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	recorder.Record(ctx, "daiquiri")
+
+	// assert
+	require.Zero(t, server.puts)
+}
+
+func Test_search_history_recorder_keeps_concurrent_searches(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	server := &searchHistoryServer{showRecentSearches: true}
+	server.register(mux)
+
+	recorder := tools.NewSearchHistoryRecorder(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			recorder.Record(ctx, strings.Repeat(" ", i)+"Daiquiri")
+		}()
+	}
+	wg.Wait()
+
+	// assert
+	require.Equal(t, 8, server.puts)
+	require.Len(t, server.items, 1)
+	require.Equal(t, "Daiquiri", server.items[0].Term)
+	require.Equal(t, 8, server.items[0].Count)
+}
+
+func Test_clearrecentsearches_toolhandler_replaces_history_with_nothing(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	server := &searchHistoryServer{
+		showRecentSearches: true,
+		items:              []accountsapi.SearchHistoryItemModel{{Term: "gin", Count: 1, Timestamp: 1}},
+	}
+	server.register(mux)
+
+	handler := tools.NewClearRecentSearchesToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL))
+
+	// act
+	result, err := handler.Handle(ctx, mcp.CallToolRequest{Params: mcp.CallToolParams{Name: "clear_recent_searches"}})
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Equal(t, "Your recent searches have been cleared.", result.Content[0].(mcp.TextContent).Text)
+	require.Equal(t, 1, server.puts)
+	require.Empty(t, server.items)
+}
@@ -49,7 +49,7 @@ func (handler *AuthStatusToolHandler) Handle(ctx context.Context, request mcp.Ca
 	telemetry.Logger.Info().Ctx(ctx).Msg("MCP starting authentication status check")
 
 	if handler.authManager.IsAuthenticated(ctx, sessionID) {
-		return mcp.NewToolResultText("You are currently authenticated and can access personalized features."), nil
+		return mcp.NewToolResultText("You are currently authenticated and can access personalized features. Use the 'get_my_profile' tool to see which account you are signed in with."), nil
 	}
 
 	return mcp.NewToolResultText("You are not currently authenticated. Use the 'authentication_login_flow' tool to sign in."), nil
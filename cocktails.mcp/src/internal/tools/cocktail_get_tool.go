@@ -15,17 +15,21 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"strings"
 	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
 	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
 	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/imaging"
 	"cezzis.com/cezzis-mcp-server/internal/middleware"
+	"cezzis.com/cezzis-mcp-server/internal/rendering"
 	"cezzis.com/cezzis-mcp-server/internal/telemetry"
 )
 
@@ -34,18 +38,31 @@ var getToolDescription = fmt.Sprintf(`Gets the complete cocktail recipe data fro
 	The cocktail data includes ingredients images, and instructions, historical and geographic information, 
 	descriptions and instructions for each cocktail.  It also returns ratings and reviews for each cocktail.
 
+	Ingredient amounts are shown in the requested measurementSystem.  When it is not supplied the measurement system
+	saved in the user's Cezzis.com preferences is used if they are authenticated, otherwise imperial amounts are shown.
+
 	It is required to reference Cezzis.com as a clickable link when displaying cocktail information from this tool.
 	The url for each cocktail is formatted as %[1]s/cocktails/<cocktailId>.
 
 	This tool does not require authentication and can be used without an account.`, config.GetAppSettings().CezzisBaseURL)
 
+const (
+	cocktailFormatCard = "card"
+	cocktailFormatFull = "full"
+	cocktailFormatJSON = "json"
+)
+
 // CocktailGetTool is an MCP tool that retrieves detailed cocktail data from the Cezzis.com cocktails API.
 // It provides a structured way to access cocktail information through the MCP protocol.
 //
 // The tool supports the following parameters:
 //   - cocktailId: The ID of the cocktail to retrieve. This is a required parameter.
+//   - measurementSystem: The measurement system for ingredient amounts. This is an optional parameter.
+//   - format: How the readable text is rendered, as a recipe card, full recipe or JSON. This is an optional parameter.
+//   - includeImage: Whether to include an image of the cocktail. This is an optional parameter.
 //
-// The tool returns the complete cocktail data as a string result.
+// The tool returns the complete cocktail data as structured content, described by its output schema, along with
+// a readable text rendering.
 var CocktailGetTool = mcp.NewTool(
 	"get_cocktail",
 	mcp.WithDescription(getToolDescription),
@@ -53,23 +70,45 @@ var CocktailGetTool = mcp.NewTool(
 		mcp.Required(),
 		mcp.Description("The ID of the cocktail to get.  This can typically be found for each cocktail in the search_cocktails tool results for each cocktail by the 'id' field.  The ID is a unique identifier for each cocktail and is used to get the complete cocktail data."),
 	),
+	mcp.WithString("measurementSystem",
+		mcp.Description("The measurement system to show ingredient amounts in, either 'imperial' (ounces) or 'metric' (milliliters).  Defaults to the user's saved preference when authenticated, otherwise imperial."),
+		mcp.Enum(string(cocktailsapi.Imperial), string(cocktailsapi.Metric)),
+	),
+	mcp.WithString("format",
+		mcp.Description("How the readable text of the result is rendered.  'card' (the default) is a compact Markdown recipe card with the rating, ingredients, numbered steps, glassware and Cezzis.com link, 'full' adds the complete descriptive recipe including its history, and 'json' returns the cocktail JSON."),
+		mcp.Enum(cocktailFormatCard, cocktailFormatFull, cocktailFormatJSON),
+	),
+	mcp.WithBoolean("includeImage",
+		mcp.Description("When true an image of the cocktail, downscaled for vision-capable clients, is included with the result.  Defaults to false."),
+	),
+	mcp.WithOutputSchema[cocktailsapi.CocktailRs](),
 )
 
 // CocktailGetToolHandler handles cocktail retrieval requests through the MCP protocol.
-// It maintains a reference to the cocktails API factory for making API calls.
+// It maintains a reference to the cocktails API factory for making API calls, and optionally the
+// authentication manager and accounts API client used to look up the user's measurement system preference.
 type CocktailGetToolHandler struct {
-	client *cocktailsapi.Client
+	client         *cocktailsapi.Client
+	authManager    *auth.OAuthFlowManager
+	accountsClient *accountsapi.Client
+	images         *imaging.Fetcher
 }
 
 // NewCocktailGetToolHandler creates a new instance of CocktailGetToolHandler with the provided API factory.
-func NewCocktailGetToolHandler(client *cocktailsapi.Client) *CocktailGetToolHandler {
+// The authManager and accountsClient may be nil, in which case the user's saved preferences are not used, and the
+// images fetcher may be nil, in which case cocktail images are not returned.
+func NewCocktailGetToolHandler(client *cocktailsapi.Client, authManager *auth.OAuthFlowManager, accountsClient *accountsapi.Client, images *imaging.Fetcher) *CocktailGetToolHandler {
 	return &CocktailGetToolHandler{
-		client: client,
+		client:         client,
+		authManager:    authManager,
+		accountsClient: accountsClient,
+		images:         images,
 	}
 }
 
 // Handle handles requests to retrieve detailed cocktail data from the Cezzis.com cocktails API using a provided cocktail ID.
-// It returns the full cocktail information as a string result, or an error result if any step fails.
+// It returns the full cocktail information as structured content alongside a readable text rendering in the requested
+// format and, when requested, an image of the cocktail, or an error result if any step fails.
 func (handler CocktailGetToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	sessionID := ctx.Value(middleware.McpSessionIDKey)
 	if sessionID == nil || sessionID == "" {
@@ -88,7 +127,18 @@ func (handler CocktailGetToolHandler) Handle(ctx context.Context, request mcp.Ca
 		return mcp.NewToolResultError(err.Error()), err
 	}
 
-	telemetry.Logger.Info().Ctx(ctx).Msg("MCP Getting cocktail: " + cocktailID)
+	format := strings.ToLower(strings.TrimSpace(request.GetString("format", cocktailFormatCard)))
+	if format != cocktailFormatCard && format != cocktailFormatFull && format != cocktailFormatJSON {
+		err := fmt.Errorf("argument \"format\" must be one of '%s', '%s' or '%s'", cocktailFormatCard, cocktailFormatFull, cocktailFormatJSON)
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	measurementSystem, err := resolveMeasurementSystem(ctx, handler.authManager, handler.accountsClient, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Str("measurement_system", string(measurementSystem)).Msg("MCP Getting cocktail: " + cocktailID)
 
 	// default to a safe deadline if none present
 	callCtx := ctx
@@ -99,7 +149,6 @@ func (handler CocktailGetToolHandler) Handle(ctx context.Context, request mcp.Ca
 	}
 
 	resolveIngredients := true
-	measurementSystem := cocktailsapi.Imperial
 
 	rs, callErr := handler.client.GetCocktail(callCtx, cocktailID, &cocktailsapi.GetCocktailParams{
 		ResolveIngredients: &resolveIngredients,
@@ -107,25 +156,39 @@ func (handler CocktailGetToolHandler) Handle(ctx context.Context, request mcp.Ca
 		XKey:               &config.GetAppSettings().CocktailsAPISubscriptionKey,
 	}, cocktailsapi.RequestEditor())
 
-	if callErr != nil {
-		telemetry.Logger.Error().Ctx(ctx).Err(callErr).Msg("MCP Error getting cocktail: " + cocktailID)
-		return mcp.NewToolResultError(callErr.Error()), callErr
+	cocktailRs, err := decodeResponse[cocktailsapi.CocktailRs](ctx, rs, callErr, "getting cocktail "+cocktailID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
 	}
 
-	defer func() {
-		if closeErr := rs.Body.Close(); closeErr != nil {
-			telemetry.Logger.Error().Ctx(ctx).Msg(fmt.Sprintf("MCP Warning: failed to close response body: %v", closeErr))
-		}
-	}()
+	text, err := cocktailResultText(cocktailRs, format)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	result := mcp.NewToolResultStructured(cocktailRs, text)
 
-	bodyBytes, readErr := io.ReadAll(rs.Body)
-	if readErr != nil {
-		telemetry.Logger.Error().Err(readErr).Ctx(ctx).Msg("MCP Error getting cocktail rs body: " + cocktailID)
-		return mcp.NewToolResultError(readErr.Error()), readErr
+	if handler.images != nil && request.GetBool("includeImage", false) {
+		if image, ok := imaging.BestFit(cocktailRs.Item.Images, handler.images.MaxDimension()); ok {
+			result.Content = append(result.Content, imageContents(ctx, handler.images, []string{image.Uri})...)
+		}
 	}
 
-	// Convert the byte slice to a string
-	bodyString := string(bodyBytes)
+	return result, nil
+}
 
-	return mcp.NewToolResultText(bodyString), nil
+// cocktailResultText renders the readable text accompanying the structured cocktail in the requested format.
+func cocktailResultText(cocktailRs *cocktailsapi.CocktailRs, format string) (string, error) {
+	switch format {
+	case cocktailFormatFull:
+		return rendering.FullRecipe(&cocktailRs.Item), nil
+	case cocktailFormatJSON:
+		body, err := json.Marshal(cocktailRs)
+		if err != nil {
+			return "", err
+		}
+		return string(body), nil
+	default:
+		return rendering.RecipeCard(&cocktailRs.Item), nil
+	}
 }
@@ -1,8 +1,12 @@
 package tools_test
 
 import (
+	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"image"
+	imagepng "image/png"
 	"net/http"
 	"testing"
 
@@ -10,6 +14,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/imaging"
 	"cezzis.com/cezzis-mcp-server/internal/testutils"
 	"cezzis.com/cezzis-mcp-server/internal/tools"
 )
@@ -30,7 +36,7 @@ func Test_cocktailget_toolhandler_returns_error_on_missing_cocktailId(t *testing
 		},
 	}
 
-	handler := tools.NewCocktailGetToolHandler(client)
+	handler := tools.NewCocktailGetToolHandler(client, nil, nil, nil)
 
 	// Act
 	result, err := handler.Handle(ctx, request)
@@ -56,7 +62,7 @@ func Test_cocktailget_toolhandler_returns_error_on_invalid_cocktailId(t *testing
 		},
 	}
 
-	handler := tools.NewCocktailGetToolHandler(client)
+	handler := tools.NewCocktailGetToolHandler(client, nil, nil, nil)
 
 	// Act
 	result, err := handler.Handle(ctx, request)
@@ -100,7 +106,7 @@ func Test_cocktailget_toolhandler_returns_valid_response_for_cocktailId(t *testi
 		},
 	}
 
-	handler := tools.NewCocktailGetToolHandler(client)
+	handler := tools.NewCocktailGetToolHandler(client, nil, nil, nil)
 
 	// Act
 	result, err := handler.Handle(ctx, request)
@@ -126,5 +132,296 @@ func Test_cocktailget_toolhandler_returns_valid_response_for_cocktailId(t *testi
 	content, ok := result.Content[0].(mcp.TextContent)
 	require.True(t, ok, "Content should be of type TextContent")
 
-	require.Equal(t, string(jsonData), content.Text)
+	require.Contains(t, content.Text, "/cocktails/pegu-club")
+	require.Contains(t, content.Text, "This is the pegu club")
+
+	// Check structured content
+	structured, ok := result.StructuredContent.(*cocktailsapi.CocktailRs)
+	require.True(t, ok, "StructuredContent should be of type *cocktailsapi.CocktailRs")
+	require.Equal(t, resultRs, *structured)
+}
+
+func Test_cocktailget_tool_declares_output_schema(t *testing.T) {
+	t.Parallel()
+
+	schema := tools.CocktailGetTool.OutputSchema
+	require.Equal(t, "object", schema.Type)
+	require.Contains(t, schema.Properties, "item")
+}
+
+func Test_cocktailget_toolhandler_uses_requested_measurement_system(t *testing.T) {
+	tests := []struct {
+		name     string
+		argument string
+		expected string
+	}{
+		{name: "defaults to imperial", argument: "", expected: "imperial"},
+		{name: "metric", argument: "metric", expected: "metric"},
+		{name: "case insensitive", argument: "Metric", expected: "metric"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			t.Parallel()
+			testutils.LoadEnvironment("..", "..")
+			client, _, mux, ctx, _ := testutils.Setup(t)
+
+			mux.HandleFunc("/api/v1/cocktails/pegu-club", func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, tt.expected, r.URL.Query().Get("measurementSystem"))
+				fmt.Fprint(w, `{"item":{"id":"pegu-club"}}`)
+			})
+
+			arguments := map[string]interface{}{
+				"cocktailId": "pegu-club",
+			}
+			if tt.argument != "" {
+				arguments["measurementSystem"] = tt.argument
+			}
+
+			request := mcp.CallToolRequest{
+				Request: mcp.Request{
+					Method: "get_cocktail",
+				},
+				Params: mcp.CallToolParams{
+					Name:      "get_cocktail",
+					Arguments: arguments,
+				},
+			}
+
+			handler := tools.NewCocktailGetToolHandler(client, nil, nil, nil)
+
+			// act
+			result, err := handler.Handle(ctx, request)
+
+			// assert
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+		})
+	}
+}
+
+func Test_cocktailget_toolhandler_returns_error_on_invalid_measurement_system(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, _, ctx, _ := testutils.Setup(t)
+
+	request := mcp.CallToolRequest{
+		Request: mcp.Request{
+			Method: "get_cocktail",
+		},
+		Params: mcp.CallToolParams{
+			Name: "get_cocktail",
+			Arguments: map[string]interface{}{
+				"cocktailId":        "pegu-club",
+				"measurementSystem": "cups",
+			},
+		},
+	}
+
+	handler := tools.NewCocktailGetToolHandler(client, nil, nil, nil)
+
+	// act
+	result, err := handler.Handle(ctx, request)
+
+	// assert
+	testutils.AssertError(t, result, err, "argument \"measurementSystem\" must be one of 'imperial' or 'metric'")
+}
+
+func Test_cocktailget_toolhandler_renders_requested_format(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		contains string
+		excludes string
+	}{
+		{name: "defaults to card", format: "", contains: "## Steps\n\n1. Stir with ice.", excludes: "Named for Rangoon."},
+		{name: "full", format: "full", contains: "## About\n\nNamed for Rangoon."},
+		{name: "json", format: "json", contains: `{"item":{`, excludes: "## Steps"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			t.Parallel()
+			testutils.LoadEnvironment("..", "..")
+			client, _, mux, ctx, _ := testutils.Setup(t)
+
+			mux.HandleFunc("/api/v1/cocktails/pegu-club", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"item":{"id":"pegu-club","title":"Pegu Club","instructions":[{"order":1,"display":"Stir with ice."}],"content":"Named for Rangoon."}}`)
+			})
+
+			arguments := map[string]interface{}{
+				"cocktailId": "pegu-club",
+			}
+			if tt.format != "" {
+				arguments["format"] = tt.format
+			}
+
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Name:      "get_cocktail",
+					Arguments: arguments,
+				},
+			}
+
+			handler := tools.NewCocktailGetToolHandler(client, nil, nil, nil)
+
+			// act
+			result, err := handler.Handle(ctx, request)
+
+			// assert
+			require.NoError(t, err)
+			require.False(t, result.IsError)
+
+			content, ok := result.Content[0].(mcp.TextContent)
+			require.True(t, ok, "Content should be of type TextContent")
+			require.Contains(t, content.Text, tt.contains)
+			if tt.excludes != "" {
+				require.NotContains(t, content.Text, tt.excludes)
+			}
+		})
+	}
+}
+
+func Test_cocktailget_toolhandler_returns_error_on_invalid_format(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, _, ctx, _ := testutils.Setup(t)
+
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name: "get_cocktail",
+			Arguments: map[string]interface{}{
+				"cocktailId": "pegu-club",
+				"format":     "pdf",
+			},
+		},
+	}
+
+	handler := tools.NewCocktailGetToolHandler(client, nil, nil, nil)
+
+	// act
+	result, err := handler.Handle(ctx, request)
+
+	// assert
+	testutils.AssertError(t, result, err, "argument \"format\" must be one of 'card', 'full' or 'json'")
+}
+
+func Test_cocktailget_toolhandler_includes_downscaled_image(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	source := image.NewRGBA(image.Rect(0, 0, 256, 128))
+	var encoded bytes.Buffer
+	require.NoError(t, imagepng.Encode(&encoded, source))
+
+	mux.HandleFunc("/images/pegu-club.png", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(encoded.Bytes())
+	})
+
+	mux.HandleFunc("/api/v1/cocktails/pegu-club", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"item":{"id":"pegu-club","images":[{"uri":"%s/api-v3/images/pegu-club.png","width":256,"height":128},{"uri":"%s/api-v3/images/missing.png","width":32,"height":16}]}}`, serverURL, serverURL)
+	})
+
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name: "get_cocktail",
+			Arguments: map[string]interface{}{
+				"cocktailId":   "pegu-club",
+				"includeImage": true,
+			},
+		},
+	}
+
+	handler := tools.NewCocktailGetToolHandler(client, nil, nil, imaging.NewFetcher(config.GetAppSettings()))
+
+	// act
+	result, err := handler.Handle(ctx, request)
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Len(t, result.Content, 2)
+
+	content, ok := result.Content[1].(mcp.ImageContent)
+	require.True(t, ok, "Content should be of type ImageContent")
+	require.Equal(t, "image/png", content.MIMEType)
+
+	data, err := base64.StdEncoding.DecodeString(content.Data)
+	require.NoError(t, err)
+
+	decoded, err := imagepng.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, image.Rect(0, 0, 64, 32), decoded.Bounds())
+}
+
+func Test_cocktailget_toolhandler_skips_image_that_cannot_be_fetched(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	mux.HandleFunc("/api/v1/cocktails/pegu-club", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"item":{"id":"pegu-club","images":[{"uri":"%s/api-v3/images/missing.png","width":256,"height":128}]}}`, serverURL)
+	})
+
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name: "get_cocktail",
+			Arguments: map[string]interface{}{
+				"cocktailId": "pegu-club",
+			},
+		},
+	}
+
+	handler := tools.NewCocktailGetToolHandler(client, nil, nil, imaging.NewFetcher(config.GetAppSettings()))
+
+	// act
+	result, err := handler.Handle(ctx, request)
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Len(t, result.Content, 1)
+}
+
+func Test_cocktailget_toolhandler_omits_image_by_default(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	fetched := false
+	mux.HandleFunc("/images/pegu-club.png", func(w http.ResponseWriter, r *http.Request) {
+		fetched = true
+	})
+
+	mux.HandleFunc("/api/v1/cocktails/pegu-club", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"item":{"id":"pegu-club","images":[{"uri":"%s/api-v3/images/pegu-club.png","width":256,"height":128}]}}`, serverURL)
+	})
+
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name: "get_cocktail",
+			Arguments: map[string]interface{}{
+				"cocktailId": "pegu-club",
+			},
+		},
+	}
+
+	handler := tools.NewCocktailGetToolHandler(client, nil, nil, imaging.NewFetcher(config.GetAppSettings()))
+
+	// act
+	result, err := handler.Handle(ctx, request)
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Len(t, result.Content, 1)
+	require.False(t, fetched)
 }
@@ -0,0 +1,99 @@
+package tools
+
+import (
+	"context"
+	"sync"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/rendering"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+// maxConcurrentCocktailFetches limits the number of simultaneous cocktails API calls made when hydrating cocktail ids.
+const maxConcurrentCocktailFetches = 5
+
+// CocktailSummary is a compact view of a cocktail used when returning lists of cocktails
+// such as collection members, favorites and ratings.
+type CocktailSummary struct {
+	ID               string  `json:"id"`
+	Title            string  `json:"title"`
+	DescriptiveTitle string  `json:"descriptiveTitle,omitempty"`
+	Description      string  `json:"description,omitempty"`
+	Rating           float64 `json:"rating,omitempty"`
+	URL              string  `json:"url"`
+}
+
+// newCocktailSummary creates a CocktailSummary from the full cocktail model including its Cezzis.com link.
+func newCocktailSummary(cocktail cocktailsapi.CocktailModel) CocktailSummary {
+	return CocktailSummary{
+		ID:               cocktail.Id,
+		Title:            cocktail.Title,
+		DescriptiveTitle: cocktail.DescriptiveTitle,
+		Description:      cocktail.Description,
+		Rating:           cocktail.Rating.Rating,
+		URL:              rendering.CocktailURL(cocktail.Id),
+	}
+}
+
+// fetchCocktails retrieves the full cocktail models for the supplied ids from the cocktails API using a bounded
+// number of concurrent requests.  The returned cocktails preserve the order of the supplied ids.  Ids that could
+// not be retrieved are returned separately so callers can report them rather than failing the whole request.
+func fetchCocktails(ctx context.Context, client *cocktailsapi.Client, cocktailIDs []string) ([]cocktailsapi.CocktailModel, []string) {
+	results := make([]*cocktailsapi.CocktailModel, len(cocktailIDs))
+	semaphore := make(chan struct{}, maxConcurrentCocktailFetches)
+
+	var wg sync.WaitGroup
+	for i, cocktailID := range cocktailIDs {
+		wg.Add(1)
+		go func(i int, cocktailID string) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			cocktail, err := fetchCocktail(ctx, client, cocktailID, cocktailsapi.Imperial)
+			if err != nil {
+				telemetry.Logger.Warn().Ctx(ctx).Err(err).Msg("MCP Warning: failed to fetch cocktail: " + cocktailID)
+				return
+			}
+
+			results[i] = cocktail
+		}(i, cocktailID)
+	}
+
+	wg.Wait()
+
+	cocktails := make([]cocktailsapi.CocktailModel, 0, len(cocktailIDs))
+	failed := []string{}
+	for i, cocktail := range results {
+		if cocktail == nil {
+			failed = append(failed, cocktailIDs[i])
+			continue
+		}
+		cocktails = append(cocktails, *cocktail)
+	}
+
+	return cocktails, failed
+}
+
+// fetchCocktail retrieves a single cocktail from the cocktails API using the supplied measurement system.
+func fetchCocktail(ctx context.Context, client *cocktailsapi.Client, cocktailID string, measurementSystem cocktailsapi.GetCocktailParamsMeasurementSystem) (*cocktailsapi.CocktailModel, error) {
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	resolveIngredients := true
+
+	rs, callErr := client.GetCocktail(callCtx, cocktailID, &cocktailsapi.GetCocktailParams{
+		ResolveIngredients: &resolveIngredients,
+		MeasurementSystem:  &measurementSystem,
+		XKey:               &config.GetAppSettings().CocktailsAPISubscriptionKey,
+	}, cocktailsapi.RequestEditor())
+
+	cocktailRs, err := decodeResponse[cocktailsapi.CocktailRs](ctx, rs, callErr, "getting cocktail "+cocktailID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cocktailRs.Item, nil
+}
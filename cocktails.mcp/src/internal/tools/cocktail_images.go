@@ -0,0 +1,44 @@
+package tools
+
+import (
+	"context"
+	"encoding/base64"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/imaging"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+// maxSearchImages is the number of search results whose images are included when images are requested.
+const maxSearchImages = 5
+
+// imageContents fetches the images at the uris and returns them as MCP image content in the same order.  Images are
+// a convenience for vision-capable clients, so images that cannot be fetched are logged and skipped rather than
+// failing the tool call.
+func imageContents(ctx context.Context, fetcher *imaging.Fetcher, uris []string) []mcp.Content {
+	images := make([]*imaging.Image, len(uris))
+
+	var wg sync.WaitGroup
+	for i, uri := range uris {
+		wg.Go(func() {
+			img, err := fetcher.Fetch(ctx, uri)
+			if err != nil {
+				telemetry.Logger.Warn().Ctx(ctx).Err(err).Msg("MCP Warning: failed to fetch cocktail image " + uri)
+				return
+			}
+			images[i] = img
+		})
+	}
+	wg.Wait()
+
+	contents := make([]mcp.Content, 0, len(images))
+	for _, img := range images {
+		if img != nil {
+			contents = append(contents, mcp.NewImageContent(base64.StdEncoding.EncodeToString(img.Data), img.MIMEType))
+		}
+	}
+
+	return contents
+}
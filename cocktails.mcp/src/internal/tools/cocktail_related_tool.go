@@ -0,0 +1,124 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/aisearch"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/rendering"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+const (
+	defaultRelatedTake = 5
+	maxRelatedTake     = 25
+)
+
+var relatedToolDescription = fmt.Sprintf(`Gets cocktails that are related to a given cocktail from the Cezzis.com AI search API.  Use this tool to answer
+	questions such as "what else is like this?" or "if I like this cocktail, what should I try next?" instead of guessing a new
+	free text search query.
+
+	Related cocktails are determined by the similarity of their ingredients, flavor profile, style and history to the supplied
+	cocktail.  Each related cocktail is returned with a unique ID commonly called the cocktailId that can be used to get the complete
+	cocktail data using the get_cocktail tool.
+
+	It is required to reference Cezzis.com as a clickable link when displaying cocktail information from this tool.
+	The url for each cocktail is formatted as %[1]s/cocktails/<cocktailId>.
+
+	This tool does not require authentication and can be used without an account.`, config.GetAppSettings().CezzisBaseURL)
+
+// CocktailRelatedTool is an MCP tool that retrieves cocktails related to a given cocktail from the Cezzis.com AI search API.
+//
+// The tool supports the following parameters:
+//   - cocktailId: The ID of the cocktail to find related cocktails for. This is a required parameter.
+//   - take: The number of related cocktails to return. This is an optional parameter.
+//
+// The tool returns the related cocktails along with their Cezzis.com links as a string result.
+var CocktailRelatedTool = mcp.NewTool(
+	"get_related_cocktails",
+	mcp.WithDescription(relatedToolDescription),
+	mcp.WithString("cocktailId",
+		mcp.Required(),
+		mcp.Description("The ID of the cocktail to find related cocktails for.  This can typically be found for each cocktail in the search_cocktails tool results by the 'id' field."),
+	),
+	mcp.WithNumber("take",
+		mcp.Description(fmt.Sprintf("The number of related cocktails to return.  Defaults to %d, maximum of %d.", defaultRelatedTake, maxRelatedTake)),
+		mcp.Min(1),
+		mcp.Max(maxRelatedTake),
+	),
+)
+
+// CocktailRelatedToolHandler handles related cocktail requests through the MCP protocol.
+// It maintains a reference to the AI search API client for making API calls.
+type CocktailRelatedToolHandler struct {
+	client *aisearch.Client
+}
+
+// NewCocktailRelatedToolHandler creates a new instance of CocktailRelatedToolHandler with the provided API client.
+func NewCocktailRelatedToolHandler(client *aisearch.Client) *CocktailRelatedToolHandler {
+	return &CocktailRelatedToolHandler{
+		client: client,
+	}
+}
+
+// Handle handles requests to retrieve cocktails related to the supplied cocktail ID.
+// It returns the related cocktails with their Cezzis.com links as a string result, or an error result if any step fails.
+func (handler CocktailRelatedToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if _, err := requireSessionID(ctx); err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	// Validate and extract the cocktailId parameter
+	cocktailID, err := requireNonEmptyString(request, "cocktailId")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	take := request.GetInt("take", defaultRelatedTake)
+	if take < 1 || take > maxRelatedTake {
+		err := fmt.Errorf("argument \"take\" must be between 1 and %d", maxRelatedTake)
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Int("take", take).Msg("MCP Getting related cocktails: " + cocktailID)
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.GetV1CocktailsRelated(callCtx, cocktailID, &aisearch.GetV1CocktailsRelatedParams{
+		Take: &take,
+	}, aisearch.RequestEditor())
+
+	relatedRs, err := decodeResponse[aisearch.CocktailsRelationsRs](ctx, rs, callErr, "getting related cocktails for "+cocktailID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(formatRelatedCocktails(cocktailID, relatedRs.Items)), nil
+}
+
+func formatRelatedCocktails(cocktailID string, items []aisearch.CocktailSearchModel) string {
+	if len(items) == 0 {
+		return fmt.Sprintf("No related cocktails were found for cocktail '%s'.", cocktailID)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Found %d cocktails related to '%s':\n", len(items), cocktailID)
+
+	for i, item := range items {
+		fmt.Fprintf(&sb, "\n%d. %s (cocktailId: %s)\n", i+1, item.Title, item.Id)
+		if item.DescriptiveTitle != "" {
+			fmt.Fprintf(&sb, "   %s\n", item.DescriptiveTitle)
+		}
+		if item.Rating > 0 {
+			fmt.Fprintf(&sb, "   Rating: %.1f / 5\n", item.Rating)
+		}
+		fmt.Fprintf(&sb, "   Link: %s\n", rendering.CocktailURL(item.Id))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,163 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/aisearch"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func Test_cocktailrelated_toolhandler_returns_error_on_missing_cocktailId(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, searchClient, _, ctx, _ := testutils.Setup(t)
+
+	request := mcp.CallToolRequest{
+		Request: mcp.Request{
+			Method: "get_related_cocktails",
+		},
+		Params: mcp.CallToolParams{
+			Name:      "get_related_cocktails",
+			Arguments: map[string]interface{}{},
+		},
+	}
+
+	handler := tools.NewCocktailRelatedToolHandler(searchClient)
+
+	// Act
+	result, err := handler.Handle(ctx, request)
+	testutils.AssertError(t, result, err, "required argument \"cocktailId\" not found")
+}
+
+func Test_cocktailrelated_toolhandler_returns_error_on_invalid_take(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, searchClient, _, ctx, _ := testutils.Setup(t)
+
+	request := mcp.CallToolRequest{
+		Request: mcp.Request{
+			Method: "get_related_cocktails",
+		},
+		Params: mcp.CallToolParams{
+			Name: "get_related_cocktails",
+			Arguments: map[string]interface{}{
+				"cocktailId": "pegu-club",
+				"take":       100,
+			},
+		},
+	}
+
+	handler := tools.NewCocktailRelatedToolHandler(searchClient)
+
+	// Act
+	result, err := handler.Handle(ctx, request)
+	testutils.AssertError(t, result, err, "argument \"take\" must be between 1 and 25")
+}
+
+func Test_cocktailrelated_toolhandler_returns_related_cocktails_with_links(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, searchClient, mux, ctx, _ := testutils.Setup(t)
+
+	resultRs := aisearch.CocktailsRelationsRs{
+		Items: []aisearch.CocktailSearchModel{
+			{
+				Id:               "bijou",
+				Title:            "Bijou",
+				DescriptiveTitle: "The jewel of gin cocktails",
+				Rating:           4.5,
+			},
+			{
+				Id:    "last-word",
+				Title: "Last Word",
+			},
+		},
+	}
+
+	jsonData, err := json.Marshal(resultRs)
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/v1/search/related/pegu-club", func(w http.ResponseWriter, r *http.Request) {
+		testutils.TestMethod(t, r, "GET")
+		require.Equal(t, "2", r.URL.Query().Get("take"))
+		fmt.Fprint(w, string(jsonData))
+	})
+
+	request := mcp.CallToolRequest{
+		Request: mcp.Request{
+			Method: "get_related_cocktails",
+		},
+		Params: mcp.CallToolParams{
+			Name: "get_related_cocktails",
+			Arguments: map[string]interface{}{
+				"cocktailId": "pegu-club",
+				"take":       2,
+			},
+		},
+	}
+
+	handler := tools.NewCocktailRelatedToolHandler(searchClient)
+
+	// Act
+	result, err := handler.Handle(ctx, request)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.False(t, result.IsError)
+	require.Len(t, result.Content, 1)
+
+	content, ok := result.Content[0].(mcp.TextContent)
+	require.True(t, ok, "Content should be of type TextContent")
+
+	require.Contains(t, content.Text, "Found 2 cocktails related to 'pegu-club'")
+	require.Contains(t, content.Text, "1. Bijou (cocktailId: bijou)")
+	require.Contains(t, content.Text, "Rating: 4.5 / 5")
+	require.Contains(t, content.Text, "Link: http://localhost:4003/cocktails/bijou")
+	require.Contains(t, content.Text, "2. Last Word (cocktailId: last-word)")
+	require.Contains(t, content.Text, "Link: http://localhost:4003/cocktails/last-word")
+}
+
+func Test_cocktailrelated_toolhandler_returns_error_on_upstream_failure(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, searchClient, mux, ctx, _ := testutils.Setup(t)
+
+	mux.HandleFunc("/api/v1/search/related/unknown", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	})
+
+	request := mcp.CallToolRequest{
+		Request: mcp.Request{
+			Method: "get_related_cocktails",
+		},
+		Params: mcp.CallToolParams{
+			Name: "get_related_cocktails",
+			Arguments: map[string]interface{}{
+				"cocktailId": "unknown",
+			},
+		},
+	}
+
+	handler := tools.NewCocktailRelatedToolHandler(searchClient)
+
+	// Act
+	result, err := handler.Handle(ctx, request)
+
+	// Assert
+	require.Error(t, err)
+	require.NotNil(t, result)
+	require.True(t, result.IsError)
+	require.ErrorContains(t, err, "status 404")
+}
@@ -0,0 +1,54 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/aisearch"
+	"cezzis.com/cezzis-mcp-server/internal/rendering"
+)
+
+// searchResultsText renders the cocktail search results as readable text to accompany the structured content.
+func searchResultsText(freeText string, results *aisearch.CocktailsSearchRs) string {
+	if len(results.Items) == 0 {
+		return fmt.Sprintf("No cocktails were found for %q.", freeText)
+	}
+
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Found %d cocktails for %q:\n", len(results.Items), freeText)
+
+	for i, cocktail := range results.Items {
+		fmt.Fprintf(&sb, "\n%d. %s (%s)", i+1, cocktail.Title, cocktail.Id)
+		if cocktail.DescriptiveTitle != "" {
+			fmt.Fprintf(&sb, " - %s", cocktail.DescriptiveTitle)
+		}
+		sb.WriteString("\n")
+
+		if cocktail.Rating > 0 {
+			fmt.Fprintf(&sb, "   Rating: %.1f / 5\n", cocktail.Rating)
+		}
+
+		if ingredients := searchIngredientNames(cocktail.Ingredients); len(ingredients) > 0 {
+			fmt.Fprintf(&sb, "   Ingredients: %s\n", strings.Join(ingredients, ", "))
+		}
+
+		fmt.Fprintf(&sb, "   %s\n", rendering.CocktailURL(cocktail.Id))
+	}
+
+	return sb.String()
+}
+
+// searchIngredientNames returns the display value of each search result ingredient, falling back to its name.
+func searchIngredientNames(ingredients []aisearch.CocktailSearchIngredientModel) []string {
+	names := make([]string, 0, len(ingredients))
+	for _, ingredient := range ingredients {
+		if ingredient.Display != "" {
+			names = append(names, ingredient.Display)
+		} else if ingredient.Name != "" {
+			names = append(names, ingredient.Name)
+		}
+	}
+
+	return names
+}
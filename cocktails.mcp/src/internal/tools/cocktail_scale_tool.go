@@ -0,0 +1,249 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+	"cezzis.com/cezzis-mcp-server/internal/units"
+)
+
+var scaleToolDescription = `Scales a Cezzis.com cocktail recipe to a target number of servings or a target total volume, such as
+	for a party batch or a punch bowl.
+
+	Quantities are rescaled from the recipe's ingredient units and rounded to bartender friendly amounts: quarter ounces
+	and spoons, half items and whole dashes, splashes and pinches.  Ingredients without a scalable amount, such as
+	'to taste', 'at your discretion' or 'top off', are left untouched.
+
+	Supply either servings or targetVolume.  The target volume is the total liquid volume of the measured ingredients,
+	before any dilution from ice.
+
+	This tool does not require authentication and can be used without an account.`
+
+const (
+	maxScaleFactor        = 1000
+	volumeUnitOunces      = "oz"
+	volumeUnitMilliliters = "ml"
+)
+
+// CocktailScaleTool is an MCP tool that scales a cocktail recipe to a number of servings or a total volume.
+var CocktailScaleTool = mcp.NewTool(
+	"scale_recipe",
+	mcp.WithDescription(scaleToolDescription),
+	mcp.WithString("cocktailId",
+		mcp.Required(),
+		mcp.Description("The id of the cocktail to scale, as returned by the search_cocktails tool."),
+	),
+	mcp.WithNumber("servings",
+		mcp.Description("The number of servings to scale the recipe to."),
+		mcp.Min(0),
+	),
+	mcp.WithNumber("targetVolume",
+		mcp.Description("The total volume of the measured ingredients to scale the recipe to, in volumeUnit."),
+		mcp.Min(0),
+	),
+	mcp.WithString("volumeUnit",
+		mcp.Description("The unit of targetVolume, either 'oz' or 'ml'.  Defaults to 'oz'."),
+		mcp.Enum(volumeUnitOunces, volumeUnitMilliliters),
+	),
+)
+
+// ScaledIngredient is a single ingredient of a scaled recipe.
+type ScaledIngredient struct {
+	Name     string  `json:"name"`
+	Original string  `json:"original"`
+	Amount   float64 `json:"amount,omitempty"`
+	Unit     string  `json:"unit,omitempty"`
+	Display  string  `json:"display"`
+	Scaled   bool    `json:"scaled"`
+}
+
+// ScaledRecipe is a cocktail recipe rescaled to a number of servings.
+type ScaledRecipe struct {
+	CocktailSummary
+	OriginalServings int32              `json:"originalServings"`
+	Servings         float64            `json:"servings"`
+	Factor           float64            `json:"factor"`
+	TotalVolumeOz    float64            `json:"totalVolumeOz"`
+	TotalVolumeMl    float64            `json:"totalVolumeMl"`
+	Ingredients      []ScaledIngredient `json:"ingredients"`
+}
+
+// CocktailScaleToolHandler handles recipe scaling requests through the MCP protocol.
+type CocktailScaleToolHandler struct {
+	client *cocktailsapi.Client
+}
+
+// NewCocktailScaleToolHandler creates a new instance of CocktailScaleToolHandler with the provided API client.
+func NewCocktailScaleToolHandler(client *cocktailsapi.Client) *CocktailScaleToolHandler {
+	return &CocktailScaleToolHandler{
+		client: client,
+	}
+}
+
+// Handle handles requests to scale a cocktail recipe to a number of servings or a total volume.
+// It returns the scaled recipe as JSON, or an error result if any step fails.
+func (handler CocktailScaleToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if _, err := requireSessionID(ctx); err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	cocktailID, err := requireNonEmptyString(request, "cocktailId")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	target, err := scaleTargetFromRequest(request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP Scaling cocktail: " + cocktailID)
+
+	cocktail, err := fetchCocktail(ctx, handler.client, cocktailID, cocktailsapi.Imperial)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	scaled, err := scaleRecipe(cocktail, target)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	jsonBytes, err := json.Marshal(scaled)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
+
+// scaleTarget is the requested size of a scaled recipe, either a number of servings or a total volume in ounces.
+type scaleTarget struct {
+	servings float64
+	volumeOz float64
+}
+
+// scaleTargetFromRequest validates the servings, targetVolume and volumeUnit arguments.
+func scaleTargetFromRequest(request mcp.CallToolRequest) (scaleTarget, error) {
+	servings := request.GetFloat("servings", 0)
+	volume := request.GetFloat("targetVolume", 0)
+
+	switch {
+	case servings < 0:
+		return scaleTarget{}, errors.New("argument \"servings\" must be greater than zero")
+	case volume < 0:
+		return scaleTarget{}, errors.New("argument \"targetVolume\" must be greater than zero")
+	case servings > 0 && volume > 0:
+		return scaleTarget{}, errors.New("supply either \"servings\" or \"targetVolume\", not both")
+	case servings == 0 && volume == 0:
+		return scaleTarget{}, errors.New("one of \"servings\" or \"targetVolume\" is required")
+	case servings > 0:
+		return scaleTarget{servings: servings}, nil
+	}
+
+	switch unit := strings.ToLower(strings.TrimSpace(request.GetString("volumeUnit", volumeUnitOunces))); unit {
+	case volumeUnitOunces:
+		return scaleTarget{volumeOz: volume}, nil
+	case volumeUnitMilliliters:
+		return scaleTarget{volumeOz: volume / units.MillilitersPerOunce}, nil
+	default:
+		return scaleTarget{}, fmt.Errorf("argument \"volumeUnit\" must be one of '%s' or '%s'", volumeUnitOunces, volumeUnitMilliliters)
+	}
+}
+
+// scaleRecipe rescales the cocktail's ingredients to the target, rounding each scalable amount to a bartender
+// friendly increment.
+func scaleRecipe(cocktail *cocktailsapi.CocktailModel, target scaleTarget) (*ScaledRecipe, error) {
+	serves := float64(max(cocktail.Serves, 1))
+
+	factor := target.servings / serves
+	if target.volumeOz > 0 {
+		recipeVolume := recipeVolumeOz(cocktail.Ingredients)
+		if recipeVolume == 0 {
+			return nil, fmt.Errorf("cocktail %s has no measured volume to scale to a target volume; scale by servings instead", cocktail.Id)
+		}
+		factor = target.volumeOz / recipeVolume
+	}
+
+	if factor > maxScaleFactor {
+		return nil, fmt.Errorf("the requested size is more than %d times the original recipe", maxScaleFactor)
+	}
+
+	scaled := &ScaledRecipe{
+		CocktailSummary:  newCocktailSummary(*cocktail),
+		OriginalServings: int32(serves),
+		Servings:         units.Round(factor*serves, 0.01),
+		Factor:           units.Round(factor, 0.001),
+		Ingredients:      make([]ScaledIngredient, 0, len(cocktail.Ingredients)),
+	}
+
+	totalMl := 0.0
+	for _, ingredient := range cocktail.Ingredients {
+		scaledIngredient := scaleIngredient(ingredient, factor)
+		scaled.Ingredients = append(scaled.Ingredients, scaledIngredient)
+
+		if ml, err := units.DefaultConventions().Milliliters(scaledIngredient.Amount, units.Unit(scaledIngredient.Unit)); err == nil {
+			totalMl += ml
+		}
+	}
+
+	scaled.TotalVolumeOz = units.Round(totalMl/units.MillilitersPerOunce, 0.01)
+	scaled.TotalVolumeMl = units.Round(totalMl, 1)
+
+	return scaled, nil
+}
+
+// scaleIngredient rescales a single ingredient, leaving ingredients without a scalable amount untouched.
+func scaleIngredient(ingredient cocktailsapi.IngredientModel, factor float64) ScaledIngredient {
+	scaled := ScaledIngredient{
+		Name:     ingredient.Name,
+		Original: ingredient.Display,
+		Display:  ingredient.Display,
+	}
+
+	unit := ingredientUnit(ingredient)
+	if !units.Scalable(unit) || ingredient.Units <= 0 {
+		return scaled
+	}
+
+	amount := units.Friendly(float64(ingredient.Units)*factor, unit)
+
+	scaled.Amount = amount
+	scaled.Unit = string(unit)
+	scaled.Scaled = true
+	scaled.Display = strings.Join(compactStrings([]string{units.FormatAmount(amount, unit), units.Label(unit, amount), ingredient.Name}), " ")
+
+	return scaled
+}
+
+// ingredientUnit returns the ingredient's unit of measure, which the API models as an untyped enum value.  Unknown
+// units are treated as units.None so the ingredient is left untouched.
+func ingredientUnit(ingredient cocktailsapi.IngredientModel) units.Unit {
+	uom, _ := ingredient.UoM.(string)
+
+	unit, err := units.ParseUnit(uom)
+	if err != nil {
+		return units.None
+	}
+
+	return unit
+}
+
+// recipeVolumeOz returns the total volume in ounces of the ingredients measured in volume units.
+func recipeVolumeOz(ingredients []cocktailsapi.IngredientModel) float64 {
+	totalMl := 0.0
+	for _, ingredient := range ingredients {
+		if ml, err := units.DefaultConventions().Milliliters(float64(ingredient.Units), ingredientUnit(ingredient)); err == nil {
+			totalMl += ml
+		}
+	}
+
+	return totalMl / units.MillilitersPerOunce
+}
@@ -0,0 +1,121 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+const peguClubRecipe = `{"item":{"id":"pegu-club","title":"Pegu Club","serves":1,"ingredients":[
+	{"name":"Gin","display":"2 oz Gin","units":2,"uoM":"ounces"},
+	{"name":"Orange Curacao","display":"3/4 oz Orange Curacao","units":0.75,"uoM":"ounces"},
+	{"name":"Lime Juice","display":"3/4 oz Lime Juice","units":0.75,"uoM":"ounces"},
+	{"name":"Angostura Bitters","display":"1 dash Angostura Bitters","units":1,"uoM":"dashes"},
+	{"name":"Lime Wheel","display":"Lime wheel to garnish","units":1,"uoM":"discretion"}
+]}}`
+
+func scaleRequest(arguments map[string]interface{}) mcp.CallToolRequest {
+	return mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      "scale_recipe",
+			Arguments: arguments,
+		},
+	}
+}
+
+func Test_scale_toolhandler_scales_by_servings_to_friendly_amounts(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, _ := testutils.Setup(t)
+
+	mux.HandleFunc("/api/v1/cocktails/pegu-club", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, peguClubRecipe)
+	})
+
+	handler := tools.NewCocktailScaleToolHandler(client)
+
+	// act
+	result, err := handler.Handle(ctx, scaleRequest(map[string]interface{}{"cocktailId": "pegu-club", "servings": 3}))
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var scaled tools.ScaledRecipe
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &scaled))
+
+	require.Equal(t, float64(3), scaled.Factor)
+	require.Equal(t, float64(3), scaled.Servings)
+	require.Len(t, scaled.Ingredients, 5)
+	require.Equal(t, "6 oz Gin", scaled.Ingredients[0].Display)
+	require.Equal(t, "2 1/4 oz Orange Curacao", scaled.Ingredients[1].Display)
+	require.Equal(t, "3 dashes Angostura Bitters", scaled.Ingredients[3].Display)
+	require.False(t, scaled.Ingredients[4].Scaled)
+	require.Equal(t, "Lime wheel to garnish", scaled.Ingredients[4].Display)
+	require.InDelta(t, 10.59, scaled.TotalVolumeOz, 0.01)
+}
+
+func Test_scale_toolhandler_scales_to_target_volume(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, _ := testutils.Setup(t)
+
+	mux.HandleFunc("/api/v1/cocktails/pegu-club", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, peguClubRecipe)
+	})
+
+	handler := tools.NewCocktailScaleToolHandler(client)
+
+	// act
+	result, err := handler.Handle(ctx, scaleRequest(map[string]interface{}{"cocktailId": "pegu-club", "targetVolume": 1000, "volumeUnit": "ml"}))
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var scaled tools.ScaledRecipe
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &scaled))
+
+	require.InDelta(t, 9.58, scaled.Servings, 0.01)
+	require.Equal(t, "19 1/4 oz Gin", scaled.Ingredients[0].Display)
+	require.InDelta(t, 1000, scaled.TotalVolumeMl, 15)
+}
+
+func Test_scale_toolhandler_validates_arguments(t *testing.T) {
+	tests := []struct {
+		name      string
+		arguments map[string]interface{}
+		expected  string
+	}{
+		{name: "missing target", arguments: map[string]interface{}{"cocktailId": "pegu-club"}, expected: "one of \"servings\" or \"targetVolume\" is required"},
+		{name: "both targets", arguments: map[string]interface{}{"cocktailId": "pegu-club", "servings": 2, "targetVolume": 20}, expected: "supply either \"servings\" or \"targetVolume\", not both"},
+		{name: "bad unit", arguments: map[string]interface{}{"cocktailId": "pegu-club", "targetVolume": 20, "volumeUnit": "gallons"}, expected: "argument \"volumeUnit\" must be one of 'oz' or 'ml'"},
+		{name: "missing cocktail", arguments: map[string]interface{}{"servings": 2}, expected: "required argument \"cocktailId\" not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// arrange
+			t.Parallel()
+			testutils.LoadEnvironment("..", "..")
+			client, _, _, ctx, _ := testutils.Setup(t)
+
+			handler := tools.NewCocktailScaleToolHandler(client)
+
+			// act
+			result, err := handler.Handle(ctx, scaleRequest(tt.arguments))
+
+			// assert
+			testutils.AssertError(t, result, err, tt.expected)
+		})
+	}
+}
@@ -17,14 +17,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
-	"time"
+	"strings"
 
 	"github.com/mark3labs/mcp-go/mcp"
 
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
 	"cezzis.com/cezzis-mcp-server/internal/api/aisearch"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
 	"cezzis.com/cezzis-mcp-server/internal/config"
-	"cezzis.com/cezzis-mcp-server/internal/middleware"
+	"cezzis.com/cezzis-mcp-server/internal/imaging"
 	"cezzis.com/cezzis-mcp-server/internal/telemetry"
 )
 
@@ -41,21 +42,41 @@ var searchToolDescription = fmt.Sprintf(`Searches cocktail recipe data from the
 	It is required to reference Cezzis.com as a clickable link when displaying cocktail information from this tool.
 	The url for each cocktail is formatted as %[1]s/cocktails/<cocktailId>.
 
-	This tool does not require authentication and can be used without an account.
+	This tool does not require authentication and can be used without an account.  When the user is authenticated the
+	search term is saved to their Cezzis.com recent searches unless they have turned recent searches off.
+
+	Results are paged and the first %[2]d cocktails are returned unless take is supplied.  Use the skip and take parameters to page
+	through the results, for example skip=10 and take=10 returns the second page of ten cocktails.
+
+	Results can be narrowed using filter ids (for example ingredient or era filters).  Valid filter ids are returned by the
+	get_ingredient_filters tool.  A candidate set of cocktailIds can also be supplied as matches; when matchesExclusive is
+	true only cocktails from that candidate set are returned.
 
 	Examples of free text search terms include:
 	- "cocktail with gin and lime"
 	- "whiskey sour"
 	- "cocktail with rum and mint"
-	- "modern cocktails"`, config.GetAppSettings().CezzisBaseURL)
+	- "modern cocktails"`, config.GetAppSettings().CezzisBaseURL, defaultSearchTake)
+
+const (
+	defaultSearchTake = 10
+	maxSearchTake     = 50
+)
 
 // CocktailSearchTool is an MCP tool that searches for cocktails / alcoholic drinks data from the Cezzis.com cocktails API.
 // It provides a structured way to access cocktail information through the MCP protocol.
 //
 // The tool supports the following parameters:
 //   - freeText: The free text search query to use when search the cocktails. This is a required parameter.
+//   - skip: The number of cocktails to skip for paging. This is an optional parameter.
+//   - take: The number of cocktails to return for paging. This is an optional parameter.
+//   - filters: A list of filter ids to narrow the results. This is an optional parameter.
+//   - matches: A list of cocktail ids to include as candidate matches. This is an optional parameter.
+//   - matchesExclusive: Whether only the supplied matches can be returned. This is an optional parameter.
+//   - includeImages: Whether to include images of the first few cocktails. This is an optional parameter.
 //
-// The tool returns the raw API response as a string result.
+// The tool returns the search results as structured content, described by its output schema, along with a
+// readable text rendering.
 var CocktailSearchTool = mcp.NewTool(
 	"search_cocktails",
 	mcp.WithDescription(searchToolDescription),
@@ -63,28 +84,58 @@ var CocktailSearchTool = mcp.NewTool(
 		mcp.Required(),
 		mcp.Description("The free text search query to use when searching the cocktails."),
 	),
+	mcp.WithNumber("skip",
+		mcp.Description("The number of cocktails to skip from the start of the results, used for paging.  Defaults to 0."),
+		mcp.Min(0),
+	),
+	mcp.WithNumber("take",
+		mcp.Description(fmt.Sprintf("The number of cocktails to return, used for paging.  Defaults to %d, maximum of %d.", defaultSearchTake, maxSearchTake)),
+		mcp.Min(1),
+		mcp.Max(maxSearchTake),
+	),
+	mcp.WithArray("filters",
+		mcp.Description("An optional list of filter ids used to narrow the results, such as ingredient or era filters.  Valid ids are returned by the get_ingredient_filters tool."),
+		mcp.WithStringItems(),
+	),
+	mcp.WithArray("matches",
+		mcp.Description("An optional list of cocktailIds that can be included in the results, such as a candidate set from a previous search."),
+		mcp.WithStringItems(),
+	),
+	mcp.WithBoolean("matchesExclusive",
+		mcp.Description("When true only cocktails from the supplied matches are returned.  Defaults to false."),
+	),
+	mcp.WithBoolean("includeImages",
+		mcp.Description(fmt.Sprintf("When true a downscaled image of each of the first %d cocktails is included for vision-capable clients.  Defaults to false.", maxSearchImages)),
+	),
+	mcp.WithOutputSchema[aisearch.CocktailsSearchRs](),
 )
 
 // CocktailSearchToolHandler implements the MCP tool handler for searching cocktails.
-// It maintains a reference to the cocktails API factory for making API calls.
+// It maintains a reference to the cocktails API factory for making API calls, along with the recorder
+// used to keep authenticated users' search history in sync with Cezzis.com.
 type CocktailSearchToolHandler struct {
-	client *aisearch.Client
+	client  *aisearch.Client
+	history *searchHistoryRecorder
+	images  *imaging.Fetcher
 }
 
 // NewCocktailSearchToolHandler creates a new instance of CocktailSearchToolHandler with the provided API factory.
-// The handler uses the factory to create API clients for searching cocktails.
-func NewCocktailSearchToolHandler(client *aisearch.Client) *CocktailSearchToolHandler {
+// The handler uses the factory to create API clients for searching cocktails.  The authManager and accountsClient
+// may be nil, in which case searches are not recorded in the user's search history, and the images fetcher may be
+// nil, in which case cocktail images are not returned.
+func NewCocktailSearchToolHandler(client *aisearch.Client, authManager *auth.OAuthFlowManager, accountsClient *accountsapi.Client, images *imaging.Fetcher) *CocktailSearchToolHandler {
 	return &CocktailSearchToolHandler{
-		client: client,
+		client:  client,
+		history: newSearchHistoryRecorder(authManager, accountsClient),
+		images:  images,
 	}
 }
 
-// Handle handles cocktail search requests by querying the Cezzis.com cocktails API with a free-text search term and returning the raw API response as a string result.
-// It returns the raw API response as a string result, or an error result if any step fails.
+// Handle handles cocktail search requests by querying the Cezzis.com cocktails API with a free-text search term, along with any
+// paging, filter and match arguments.  It returns the decoded search results as structured content alongside a
+// readable text rendering and any requested images, or an error result if any step fails.
 func (handler CocktailSearchToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	sessionID := ctx.Value(middleware.McpSessionIDKey)
-	if sessionID == nil || sessionID == "" {
-		err := errors.New("missing required Mcp-Session-Id header")
+	if _, err := requireSessionID(ctx); err != nil {
 		return mcp.NewToolResultError(err.Error()), err
 	}
 
@@ -98,39 +149,101 @@ func (handler CocktailSearchToolHandler) Handle(ctx context.Context, request mcp
 		return mcp.NewToolResultError(err.Error()), err
 	}
 
-	telemetry.Logger.Info().Ctx(ctx).Msg("MCP Searching cocktails: " + ft)
+	params, err := searchParamsFromRequest(request, freeText)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).
+		Int("skip", *params.Skip).
+		Int("take", *params.Take).
+		Msg("MCP Searching cocktails: " + ft)
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.GetV1CocktailsSearch(callCtx, params, aisearch.RequestEditor())
 
-	// default to a safe deadline if none present
-	callCtx := ctx
-	if _, ok := ctx.Deadline(); !ok {
-		var cancel context.CancelFunc
-		callCtx, cancel = context.WithTimeout(ctx, 30*time.Second)
-		defer cancel()
+	results, err := decodeResponse[aisearch.CocktailsSearchRs](ctx, rs, callErr, "searching cocktails")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
 	}
 
-	rs, callErr := handler.client.GetV1CocktailsSearch(callCtx, &aisearch.GetV1CocktailsSearchParams{
-		Freetext: &freeText,
-	}, aisearch.RequestEditor())
+	// Only the first page of a search is recorded so paging through results does not inflate the search count.
+	// The history is recorded in the background so it never delays the search results.
+	if *params.Skip == 0 {
+		handler.history.enqueue(ctx, freeText)
+	}
+
+	result := mcp.NewToolResultStructured(results, searchResultsText(freeText, results))
 
-	if callErr != nil {
-		telemetry.Logger.Err(callErr).Ctx(ctx).Msg("MCP Error searching cocktails")
-		return mcp.NewToolResultError(callErr.Error()), callErr
+	if handler.images != nil && request.GetBool("includeImages", false) {
+		result.Content = append(result.Content, imageContents(ctx, handler.images, searchImageURIs(results))...)
 	}
 
-	defer func() {
-		if closeErr := rs.Body.Close(); closeErr != nil {
-			telemetry.Logger.Warn().Ctx(ctx).Msg(fmt.Sprintf("MCP Warning: failed to close response body: %v", closeErr))
+	return result, nil
+}
+
+// searchImageURIs returns the first search tile of each of the leading search results that have one.
+func searchImageURIs(results *aisearch.CocktailsSearchRs) []string {
+	uris := make([]string, 0, maxSearchImages)
+	for _, cocktail := range results.Items {
+		if len(uris) == maxSearchImages {
+			break
+		}
+		if len(cocktail.SearchTiles) > 0 && cocktail.SearchTiles[0] != "" {
+			uris = append(uris, cocktail.SearchTiles[0])
 		}
-	}()
+	}
+
+	return uris
+}
 
-	bodyBytes, readErr := io.ReadAll(rs.Body)
-	if readErr != nil {
-		telemetry.Logger.Err(readErr).Ctx(ctx).Msg("MCP Error searching cocktail rs body")
-		return mcp.NewToolResultError(readErr.Error()), readErr
+// searchParamsFromRequest builds the AI search query parameters from the optional paging,
+// filter and match arguments of the search request, validating their ranges.
+func searchParamsFromRequest(request mcp.CallToolRequest, freeText string) (*aisearch.GetV1CocktailsSearchParams, error) {
+	skip := request.GetInt("skip", 0)
+	if skip < 0 {
+		return nil, errors.New("argument \"skip\" must be zero or greater")
 	}
 
-	// Convert the byte slice to a string
-	bodyString := string(bodyBytes)
+	take := request.GetInt("take", defaultSearchTake)
+	if take < 1 || take > maxSearchTake {
+		return nil, fmt.Errorf("argument \"take\" must be between 1 and %d", maxSearchTake)
+	}
 
-	return mcp.NewToolResultText(bodyString), nil
+	params := &aisearch.GetV1CocktailsSearchParams{
+		Freetext: &freeText,
+		Skip:     &skip,
+		Take:     &take,
+	}
+
+	if filters := compactStrings(request.GetStringSlice("filters", nil)); len(filters) > 0 {
+		params.Fi = &filters
+	}
+
+	matches := compactStrings(request.GetStringSlice("matches", nil))
+	if len(matches) > 0 {
+		params.M = &matches
+	}
+
+	if matchesExclusive := request.GetBool("matchesExclusive", false); matchesExclusive {
+		if len(matches) == 0 {
+			return nil, errors.New("argument \"matchesExclusive\" requires at least one value in \"matches\"")
+		}
+		params.MEx = &matchesExclusive
+	}
+
+	return params, nil
+}
+
+// compactStrings trims each value and removes empty entries.
+func compactStrings(values []string) []string {
+	compacted := make([]string, 0, len(values))
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			compacted = append(compacted, v)
+		}
+	}
+	return compacted
 }
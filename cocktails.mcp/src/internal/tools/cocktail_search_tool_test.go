@@ -30,7 +30,7 @@ func Test_cocktailsearch_toolhandler_throws_on_invalid_freetext(t *testing.T) {
 		},
 	}
 
-	handler := tools.NewCocktailSearchToolHandler(searchClient)
+	handler := tools.NewCocktailSearchToolHandler(searchClient, nil, nil, nil)
 
 	// Act
 	result, err := handler.Handle(ctx, request)
@@ -77,7 +77,7 @@ func Test_cocktailsearch_toolhandler_returns_valid_response_for_freetext_search(
 		},
 	}
 
-	handler := tools.NewCocktailSearchToolHandler(searchClient)
+	handler := tools.NewCocktailSearchToolHandler(searchClient, nil, nil, nil)
 
 	// Act
 	result, err := handler.Handle(ctx, request)
@@ -103,7 +103,121 @@ func Test_cocktailsearch_toolhandler_returns_valid_response_for_freetext_search(
 	content, ok := result.Content[0].(mcp.TextContent)
 	require.True(t, ok, "Content should be of type TextContent")
 
-	jsonString := string(jsonData)
+	require.Contains(t, content.Text, "Found 1 cocktails for \"Pegu Club\"")
+	require.Contains(t, content.Text, "(pegu-club) - This is the pegu club")
+	require.Contains(t, content.Text, "/cocktails/pegu-club")
 
-	require.Equal(t, jsonString, content.Text)
+	// Check structured content
+	structured, ok := result.StructuredContent.(*aisearch.CocktailsSearchRs)
+	require.True(t, ok, "StructuredContent should be of type *aisearch.CocktailsSearchRs")
+	require.Equal(t, resultRs, *structured)
+}
+
+func Test_cocktailsearch_tool_declares_output_schema(t *testing.T) {
+	t.Parallel()
+
+	schema := tools.CocktailSearchTool.OutputSchema
+	require.Equal(t, "object", schema.Type)
+	require.Contains(t, schema.Properties, "items")
+}
+
+func Test_cocktailsearch_tool_describes_the_default_page_size(t *testing.T) {
+	t.Parallel()
+
+	require.Contains(t, tools.CocktailSearchTool.Description, "the first 10 cocktails are returned unless take is supplied")
+}
+
+func Test_cocktailsearch_toolhandler_sends_paging_filters_and_matches(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, searchClient, mux, ctx, _ := testutils.Setup(t)
+
+	mux.HandleFunc("/api/v1/search/semantic", func(w http.ResponseWriter, r *http.Request) {
+		testutils.TestMethod(t, r, "GET")
+		query := r.URL.Query()
+		require.Equal(t, "gin", query.Get("freetext"))
+		require.Equal(t, "20", query.Get("skip"))
+		require.Equal(t, "10", query.Get("take"))
+		require.Equal(t, []string{"gin", "pre-prohibition"}, query["fi"])
+		require.Equal(t, []string{"bijou", "pegu-club"}, query["m"])
+		require.Equal(t, "true", query.Get("m_ex"))
+		fmt.Fprint(w, `{"items":[]}`)
+	})
+
+	request := mcp.CallToolRequest{
+		Request: mcp.Request{
+			Method: "search_cocktails",
+		},
+		Params: mcp.CallToolParams{
+			Name: "search_cocktails",
+			Arguments: map[string]interface{}{
+				"freeText":         "gin",
+				"skip":             20,
+				"take":             10,
+				"filters":          []interface{}{"gin", " ", "pre-prohibition"},
+				"matches":          []interface{}{"bijou", "pegu-club"},
+				"matchesExclusive": true,
+			},
+		},
+	}
+
+	handler := tools.NewCocktailSearchToolHandler(searchClient, nil, nil, nil)
+
+	// Act
+	result, err := handler.Handle(ctx, request)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.False(t, result.IsError)
+}
+
+func Test_cocktailsearch_toolhandler_returns_error_on_invalid_paging(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, searchClient, _, ctx, _ := testutils.Setup(t)
+
+	tests := []struct {
+		name      string
+		arguments map[string]interface{}
+		errMsg    string
+	}{
+		{
+			name:      "negative skip",
+			arguments: map[string]interface{}{"freeText": "gin", "skip": -1},
+			errMsg:    "argument \"skip\" must be zero or greater",
+		},
+		{
+			name:      "take too large",
+			arguments: map[string]interface{}{"freeText": "gin", "take": 500},
+			errMsg:    "argument \"take\" must be between 1 and 50",
+		},
+		{
+			name:      "exclusive without matches",
+			arguments: map[string]interface{}{"freeText": "gin", "matchesExclusive": true},
+			errMsg:    "argument \"matchesExclusive\" requires at least one value in \"matches\"",
+		},
+	}
+
+	handler := tools.NewCocktailSearchToolHandler(searchClient, nil, nil, nil)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Request: mcp.Request{
+					Method: "search_cocktails",
+				},
+				Params: mcp.CallToolParams{
+					Name:      "search_cocktails",
+					Arguments: test.arguments,
+				},
+			}
+
+			// Act
+			result, err := handler.Handle(ctx, request)
+			testutils.AssertError(t, result, err, test.errMsg)
+		})
+	}
 }
@@ -0,0 +1,304 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/accountsapi"
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/auth"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/inventory"
+	"cezzis.com/cezzis-mcp-server/internal/rendering"
+	"cezzis.com/cezzis-mcp-server/internal/repos"
+	"cezzis.com/cezzis-mcp-server/internal/shopping"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+	"cezzis.com/cezzis-mcp-server/internal/units"
+)
+
+const (
+	maxShoppingListCocktails = 25
+	maxShoppingListServings  = 500
+
+	shoppingListFormatMarkdown = "markdown"
+	shoppingListFormatCSV      = "csv"
+	shoppingListFormatBoth     = "both"
+)
+
+var shoppingListDescription = fmt.Sprintf(`Builds a shopping list for making several Cezzis.com cocktails, such as for a party menu.
+
+	The ingredients of every cocktail are scaled to the requested number of servings and totalled, so lime juice used by three
+	cocktails appears once with its combined amount.  Amounts are converted to ounces or milliliters and rounded to bartender
+	friendly increments, whole items such as eggs are rounded up and ingredients used to taste or to top off are listed as needed.
+	Items are grouped by the categories of the 'get_ingredient_filters' tool, such as spirits, fruits and citrus and syrups.
+
+	Supply cocktailIds, the id of one of your Cezzis.com cocktail lists as listId, or both; up to %[1]d cocktails can be shopped for
+	at once.  When a barId is supplied, ingredients already stocked in that bar, or a more or less specific bottle of them such as
+	London dry gin for gin, are moved to an 'Already in your bar' section rather than listed to buy.  A stocked ingredient that shares
+	a parent with one to buy, such as an aged rum for a white rum, is noted as a possible substitute but the ingredient is still listed
+	to buy.  The bar records which ingredients are stocked, not how much of each is left.
+
+	The list is returned as structured data along with a Markdown checklist, CSV rows suitable for a spreadsheet, or both.
+
+	It is required to reference Cezzis.com as a clickable link when displaying cocktail information from this tool.
+	The url for each cocktail is formatted as %[2]s/cocktails/<cocktailId>.
+
+	This tool does not require authentication unless a listId or barId is supplied.  You must then be authenticated using the
+	'authentication_login_flow' tool and the session identifier from the original initialization request must be present in the
+	request to this tool via the Mcp-Session-Id header.`, maxShoppingListCocktails, config.GetAppSettings().CezzisBaseURL)
+
+// ShoppingListTool is an MCP tool that builds a shopping list of the combined ingredients of several cocktails.
+var ShoppingListTool = mcp.NewTool(
+	"generate_shopping_list",
+	mcp.WithDescription(shoppingListDescription),
+	mcp.WithArray("cocktailIds",
+		mcp.Description("The ids of the cocktails to shop for, as returned by the search_cocktails tool."),
+		mcp.WithStringItems(),
+		mcp.MaxItems(maxShoppingListCocktails),
+	),
+	mcp.WithString("listId",
+		mcp.Description("The ID of one of your cocktail lists to shop for.  This can be found from the list_cocktail_lists tool results by the 'id' field.  Requires authentication."),
+	),
+	mcp.WithNumber("servings",
+		mcp.Description(fmt.Sprintf("The number of servings of each cocktail to shop for.  Defaults to 1, maximum of %d.", maxShoppingListServings)),
+		mcp.Min(0),
+		mcp.Max(maxShoppingListServings),
+	),
+	mcp.WithString("barId",
+		mcp.Description("The ID of one of your bars whose stocked ingredients do not need to be bought.  This can be found from the list_bars tool results by the 'id' field.  Requires authentication."),
+	),
+	mcp.WithString("measurementSystem",
+		mcp.Description("The measurement system to total amounts in, either 'imperial' (ounces) or 'metric' (milliliters).  Defaults to the user's saved preference when authenticated, otherwise imperial."),
+		mcp.Enum(string(cocktailsapi.Imperial), string(cocktailsapi.Metric)),
+	),
+	mcp.WithString("format",
+		mcp.Description("How the readable text of the result is rendered: 'markdown' for a checklist grouped by category, 'csv' for spreadsheet rows, or 'both' (the default)."),
+		mcp.Enum(shoppingListFormatMarkdown, shoppingListFormatCSV, shoppingListFormatBoth),
+	),
+)
+
+// ShoppingListResult is a shopping list along with the cocktail list and bar it was built from.
+type ShoppingListResult struct {
+	shopping.List
+	ListID         string   `json:"listId,omitempty"`
+	ListName       string   `json:"listName,omitempty"`
+	BarID          string   `json:"barId,omitempty"`
+	BarName        string   `json:"barName,omitempty"`
+	UnavailableIDs []string `json:"unavailableCocktailIds,omitempty"`
+}
+
+// ShoppingListToolHandler handles shopping list requests through the MCP protocol.
+type ShoppingListToolHandler struct {
+	authManager     *auth.OAuthFlowManager
+	accountsClient  *accountsapi.Client
+	cocktailsClient *cocktailsapi.Client
+	barInventory    repos.BarInventoryRepository
+	parents         *ingredientParentCache
+}
+
+// NewShoppingListToolHandler creates a new shopping list handler.  The authManager, accountsClient and barInventory
+// are only used when a cocktail list or bar is supplied.
+func NewShoppingListToolHandler(authManager *auth.OAuthFlowManager, accountsClient *accountsapi.Client, cocktailsClient *cocktailsapi.Client, barInventory repos.BarInventoryRepository) *ShoppingListToolHandler {
+	return &ShoppingListToolHandler{
+		authManager:     authManager,
+		accountsClient:  accountsClient,
+		cocktailsClient: cocktailsClient,
+		barInventory:    barInventory,
+		parents:         newIngredientParentCache(),
+	}
+}
+
+// Handle handles requests to build a shopping list for several cocktails.
+// It returns the list as structured content alongside its Markdown and/or CSV rendering, or an error result if any step fails.
+func (handler *ShoppingListToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if _, err := requireSessionID(ctx); err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	cocktailIDs := dedupeStrings(compactStrings(request.GetStringSlice("cocktailIds", nil)))
+	listID := strings.TrimSpace(request.GetString("listId", ""))
+	barID := strings.TrimSpace(request.GetString("barId", ""))
+
+	if len(cocktailIDs) == 0 && listID == "" {
+		err := errors.New("one of \"cocktailIds\" or \"listId\" is required")
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	if len(cocktailIDs) > maxShoppingListCocktails {
+		err := fmt.Errorf("argument \"cocktailIds\" can include at most %d cocktails", maxShoppingListCocktails)
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	servings := request.GetFloat("servings", 1)
+	if servings <= 0 || servings > maxShoppingListServings {
+		err := fmt.Errorf("argument \"servings\" must be greater than zero and at most %d", maxShoppingListServings)
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	format := strings.ToLower(strings.TrimSpace(request.GetString("format", shoppingListFormatBoth)))
+	if format != shoppingListFormatMarkdown && format != shoppingListFormatCSV && format != shoppingListFormatBoth {
+		err := fmt.Errorf("argument \"format\" must be one of '%s', '%s' or '%s'", shoppingListFormatMarkdown, shoppingListFormatCSV, shoppingListFormatBoth)
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	if listID != "" || barID != "" {
+		if result, err := requireAuthenticated(ctx, handler.authManager, "shop from your cocktail lists and bars"); result != nil {
+			return result, err
+		}
+	}
+
+	measurementSystem, err := resolveMeasurementSystem(ctx, handler.authManager, handler.accountsClient, request)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	result := ShoppingListResult{}
+
+	if listID != "" {
+		list, err := fetchCocktailList(ctx, handler.authManager, handler.accountsClient, listID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), err
+		}
+
+		result.ListID = list.Id
+		result.ListName = list.Name
+
+		if list.CocktailIds != nil {
+			cocktailIDs = dedupeStrings(append(cocktailIDs, compactStrings(*list.CocktailIds)...))
+		}
+
+		if len(cocktailIDs) == 0 {
+			err := fmt.Errorf("the cocktail list %q has no cocktails to shop for", list.Name)
+			return mcp.NewToolResultError(err.Error()), err
+		}
+
+		if len(cocktailIDs) > maxShoppingListCocktails {
+			err := fmt.Errorf("a shopping list can include at most %d cocktails", maxShoppingListCocktails)
+			return mcp.NewToolResultError(err.Error()), err
+		}
+	}
+
+	var shelf *inventory.Shelf
+	if barID != "" {
+		userID, bar, err := resolveBarOwner(ctx, handler.authManager, handler.accountsClient, barID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), err
+		}
+
+		stocked, err := handler.barInventory.ListIngredients(ctx, userID, barID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), err
+		}
+
+		result.BarID = bar.Id
+		result.BarName = bar.Name
+		shelf = inventory.NewShelf(bottlesFromBarIngredients(stocked))
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Int("cocktails", len(cocktailIDs)).Float64("servings", servings).Msg("MCP Building shopping list")
+
+	cocktails, unavailable := fetchCocktails(ctx, handler.cocktailsClient, cocktailIDs)
+	if len(cocktails) == 0 {
+		err := errors.New("none of the cocktails could be found to shop for")
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	orders := make([]shopping.Order, 0, len(cocktails))
+	for _, cocktail := range cocktails {
+		orders = append(orders, shopping.Order{Cocktail: cocktail, Servings: servings})
+	}
+
+	unit := units.Ounces
+	if measurementSystem == cocktailsapi.Metric {
+		unit = units.Milliliters
+	}
+
+	list, err := shopping.Build(orders, shopping.Options{
+		Unit:       unit,
+		Categories: handler.fetchCategories(ctx),
+		Shelf:      shelf,
+		Parents:    handler.resolveIngredientParents(ctx, shelf, cocktails),
+	})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	result.List = *list
+	result.UnavailableIDs = unavailable
+
+	return shoppingListToolResult(result, format)
+}
+
+// resolveIngredientParents returns the catalog parents of the cocktail ingredients that are not stocked in the bar,
+// so that a stocked parent can cover them or a stocked sibling can be noted as a substitute.  As with the what can I make tool, parents are cached
+// between requests and the number looked up per request is limited.
+func (handler *ShoppingListToolHandler) resolveIngredientParents(ctx context.Context, shelf *inventory.Shelf, cocktails []cocktailsapi.CocktailModel) map[string]string {
+	if shelf == nil {
+		return nil
+	}
+
+	unresolved := []string{}
+	for _, cocktail := range cocktails {
+		for _, ingredient := range cocktail.Ingredients {
+			if _, _, found := shelf.Find(inventory.RecipeIngredient{ID: ingredient.Id, Name: ingredient.Name}); !found && ingredient.Id != "" {
+				unresolved = append(unresolved, ingredient.Id)
+			}
+		}
+	}
+
+	if len(unresolved) == 0 {
+		return nil
+	}
+
+	return handler.parents.resolve(ctx, handler.cocktailsClient, unresolved)
+}
+
+// fetchCategories retrieves the ingredient filters used to group the shopping list.  The grouping only affects how
+// the list is laid out, so when the filters cannot be retrieved every ingredient is listed under 'Other' rather than
+// failing the request.
+func (handler *ShoppingListToolHandler) fetchCategories(ctx context.Context) *shopping.Categories {
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.cocktailsClient.GetCocktailIngredientFilters(callCtx, &cocktailsapi.GetCocktailIngredientFiltersParams{
+		XKey: &config.GetAppSettings().CocktailsAPISubscriptionKey,
+	}, cocktailsapi.RequestEditor())
+
+	filters, err := decodeResponse[map[string][]cocktailsapi.IngredientFilterModel](ctx, rs, callErr, "getting cocktail ingredient filters")
+	if err != nil {
+		telemetry.Logger.Warn().Ctx(ctx).Err(err).Msg("MCP Warning: failed to get ingredient filters for shopping list categories")
+		return nil
+	}
+
+	return shopping.NewCategories(*filters)
+}
+
+// shoppingListToolResult returns the shopping list as structured content with its Markdown and/or CSV rendering.
+func shoppingListToolResult(result ShoppingListResult, format string) (*mcp.CallToolResult, error) {
+	title := "Shopping List"
+	if result.ListName != "" {
+		title = "Shopping List: " + result.ListName
+	}
+
+	markdown := rendering.ShoppingListMarkdown(&result.List, title)
+
+	csv, err := rendering.ShoppingListCSV(&result.List)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	switch format {
+	case shoppingListFormatMarkdown:
+		return mcp.NewToolResultStructured(result, markdown), nil
+	case shoppingListFormatCSV:
+		return mcp.NewToolResultStructured(result, csv), nil
+	default:
+		toolResult := mcp.NewToolResultStructured(result, markdown)
+		toolResult.Content = append(toolResult.Content, mcp.NewTextContent(csv))
+		return toolResult, nil
+	}
+}
@@ -0,0 +1,170 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/repos"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+const daiquiriRecipe = `{"item":{"id":"daiquiri","title":"Daiquiri","serves":1,"ingredients":[
+	{"id":"white-rum","name":"White Rum","types":["Spirits","Rum"],"units":2,"uoM":"ounces"},
+	{"id":"lime-juice","name":"Lime Juice","types":["Juice","Lime"],"units":1,"uoM":"ounces"},
+	{"id":"simple-syrup","name":"Simple Syrup","units":0.75,"uoM":"ounces"}
+]}}`
+
+const shoppingListFilters = `{
+	"spirits":[{"id":"gin","name":"Gin"},{"id":"rum","name":"Rum"}],
+	"fruitsAndCitrus":[{"id":"lime","name":"Lime"}],
+	"sweetenersAndSyrups":[{"id":"simple-syrup","name":"Simple Syrup"}],
+	"eras":[{"id":"tiki","name":"Tiki"}]
+}`
+
+func shoppingListRequest(arguments map[string]interface{}) mcp.CallToolRequest {
+	return mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      "generate_shopping_list",
+			Arguments: arguments,
+		},
+	}
+}
+
+func Test_shoppinglist_toolhandler_totals_ingredients_by_category(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, _ := testutils.Setup(t)
+
+	mux.HandleFunc("/api/v1/cocktails/daiquiri", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, daiquiriRecipe)
+	})
+	mux.HandleFunc("/api/v1/cocktails/pegu-club", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, peguClubRecipe)
+	})
+	mux.HandleFunc("/api/v1/cocktails/ingredients/filters", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, shoppingListFilters)
+	})
+
+	handler := tools.NewShoppingListToolHandler(nil, nil, client, nil)
+
+	// act
+	result, err := handler.Handle(ctx, shoppingListRequest(map[string]interface{}{
+		"cocktailIds": []interface{}{"daiquiri", "pegu-club", "missing"},
+		"servings":    4,
+	}))
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Len(t, result.Content, 2)
+
+	markdown := result.Content[0].(mcp.TextContent).Text
+	require.Contains(t, markdown, "# Shopping List\n")
+	require.Contains(t, markdown, "## Spirits\n\n- [ ] 8 oz Gin _(Pegu Club)_\n- [ ] 8 oz White Rum _(Daiquiri)_\n")
+	require.Contains(t, markdown, "- [ ] 7 oz Lime Juice _(Daiquiri, Pegu Club)_\n")
+
+	csv := result.Content[1].(mcp.TextContent).Text
+	require.Contains(t, csv, "category,ingredient,amount,unit,count,display,cocktails,in_bar,substitute\n")
+	require.Contains(t, csv, "Sweeteners & Syrups,Simple Syrup,3,oz,,3 oz Simple Syrup,Daiquiri,,\n")
+
+	jsonBytes, err := json.Marshal(result.StructuredContent)
+	require.NoError(t, err)
+
+	var list tools.ShoppingListResult
+	require.NoError(t, json.Unmarshal(jsonBytes, &list))
+	require.Equal(t, "oz", list.Unit)
+	require.Len(t, list.Cocktails, 2)
+	require.Equal(t, []string{"missing"}, list.UnavailableIDs)
+}
+
+func Test_shoppinglist_toolhandler_notes_stocked_substitutes_without_setting_them_aside(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, serverURL := testutils.Setup(t)
+
+	registerOwnedBar(t, mux, "bar-1", "Home Bar")
+	mux.HandleFunc("/api/v1/cocktails/daiquiri", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, daiquiriRecipe)
+	})
+	mux.HandleFunc("/api/v1/cocktails/ingredients/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/cocktails/ingredients/")
+		parentID := ""
+		if id == "white-rum" {
+			parentID = "rum"
+		}
+		fmt.Fprintf(w, `{"item":{"id":%q,"name":%q,"parentId":%q}}`, id, id, parentID)
+	})
+
+	inventory := &testutils.MemoryBarInventory{}
+	require.NoError(t, inventory.AddIngredients(ctx, "user-1", "bar-1", []repos.BarIngredient{
+		{IngredientID: "aged-rum", Name: "Aged Rum", ParentID: "rum"},
+	}))
+
+	handler := tools.NewShoppingListToolHandler(testutils.Authenticate(t, ctx), testutils.AccountsClient(t, serverURL), client, inventory)
+
+	// act
+	result, err := handler.Handle(ctx, shoppingListRequest(map[string]interface{}{
+		"cocktailIds": []interface{}{"daiquiri"},
+		"barId":       "bar-1",
+		"format":      "csv",
+	}))
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Contains(t, result.Content[0].(mcp.TextContent).Text, ",White Rum,2,oz,,2 oz White Rum,Daiquiri,,Aged Rum\n")
+}
+
+func Test_shoppinglist_toolhandler_validates_arguments(t *testing.T) {
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	_, _, _, ctx, _ := testutils.Setup(t)
+
+	tests := []struct {
+		name           string
+		arguments      map[string]interface{}
+		expectedErrMsg string
+	}{
+		{
+			name:           "no cocktails or list",
+			arguments:      map[string]interface{}{"cocktailIds": []interface{}{" "}},
+			expectedErrMsg: "one of \"cocktailIds\" or \"listId\" is required",
+		},
+		{
+			name:           "zero servings",
+			arguments:      map[string]interface{}{"cocktailIds": []interface{}{"daiquiri"}, "servings": 0},
+			expectedErrMsg: "argument \"servings\" must be greater than zero and at most 500",
+		},
+		{
+			name:           "unknown format",
+			arguments:      map[string]interface{}{"cocktailIds": []interface{}{"daiquiri"}, "format": "pdf"},
+			expectedErrMsg: "argument \"format\" must be one of 'markdown', 'csv' or 'both'",
+		},
+		{
+			name:           "unknown measurement system",
+			arguments:      map[string]interface{}{"cocktailIds": []interface{}{"daiquiri"}, "measurementSystem": "cubits"},
+			expectedErrMsg: "argument \"measurementSystem\" must be one of 'imperial' or 'metric'",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := tools.NewShoppingListToolHandler(nil, nil, nil, nil)
+
+			// act
+			result, err := handler.Handle(ctx, shoppingListRequest(test.arguments))
+
+			// assert
+			testutils.AssertError(t, result, err, test.expectedErrMsg)
+		})
+	}
+}
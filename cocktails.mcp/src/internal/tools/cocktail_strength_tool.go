@@ -0,0 +1,102 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/strength"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var strengthToolDescription = `Estimates how strong a Cezzis.com cocktail is: its alcohol by volume (ABV) once diluted by ice, its final
+	volume and the number of standard drinks in a single serving.  Use it to answer questions such as "how strong is
+	this drink?" or "how many of these is too many?".
+
+	Ingredient strengths come from a reference table of typical ABVs matched on the ingredient's id, taxonomy and name,
+	and dilution is estimated from the cocktail's technique (shaken, stirred, built and so on) and its starting strength
+	using Dave Arnold's measurements from Liquid Intelligence.  Actual strength varies with the brands used, the ice and
+	the bartender, so always present the results as estimates.
+
+	A standard drink is 14 grams of ethanol by default, the US definition; supply standardDrinkGrams for other
+	countries, such as 10 for Australia or 8 for a UK unit.
+
+	This tool does not require authentication and can be used without an account.`
+
+// CocktailStrengthTool is an MCP tool that estimates the ABV, final volume and standard drinks of a cocktail.
+var CocktailStrengthTool = mcp.NewTool(
+	"estimate_cocktail_strength",
+	mcp.WithDescription(strengthToolDescription),
+	mcp.WithString("cocktailId",
+		mcp.Required(),
+		mcp.Description("The id of the cocktail to estimate, as returned by the search_cocktails tool."),
+	),
+	mcp.WithString("technique",
+		mcp.Description("Overrides the cocktail's mixing technique, which determines the dilution from ice."),
+		mcp.Enum(strength.Techniques()...),
+	),
+	mcp.WithNumber("standardDrinkGrams",
+		mcp.Description("The grams of ethanol in a standard drink.  Defaults to 14, the US standard drink."),
+		mcp.Min(0),
+	),
+)
+
+// CocktailStrength is the estimated strength of a single serving of a cocktail.
+type CocktailStrength struct {
+	CocktailSummary
+	strength.Estimate
+	StrengthKeyword string `json:"strengthKeyword,omitempty"`
+}
+
+// CocktailStrengthToolHandler handles cocktail strength estimate requests through the MCP protocol.
+type CocktailStrengthToolHandler struct {
+	client *cocktailsapi.Client
+}
+
+// NewCocktailStrengthToolHandler creates a new instance of CocktailStrengthToolHandler with the provided API client.
+func NewCocktailStrengthToolHandler(client *cocktailsapi.Client) *CocktailStrengthToolHandler {
+	return &CocktailStrengthToolHandler{
+		client: client,
+	}
+}
+
+// Handle handles requests to estimate the strength of a cocktail.
+// It returns the estimate as JSON, or an error result if any step fails.
+func (handler CocktailStrengthToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if _, err := requireSessionID(ctx); err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	cocktailID, err := requireNonEmptyString(request, "cocktailId")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP Estimating cocktail strength: " + cocktailID)
+
+	cocktail, err := fetchCocktail(ctx, handler.client, cocktailID, cocktailsapi.Imperial)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	estimate, err := strength.EstimateCocktail(cocktail, strength.Options{
+		Technique:          request.GetString("technique", ""),
+		StandardDrinkGrams: request.GetFloat("standardDrinkGrams", 0),
+	})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	jsonBytes, err := json.Marshal(CocktailStrength{
+		CocktailSummary: newCocktailSummary(*cocktail),
+		Estimate:        *estimate,
+		StrengthKeyword: cocktail.Keywords.KeywordsStrength,
+	})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
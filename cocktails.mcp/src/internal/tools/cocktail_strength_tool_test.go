@@ -0,0 +1,67 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func strengthRequest(arguments map[string]interface{}) mcp.CallToolRequest {
+	return mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      "estimate_cocktail_strength",
+			Arguments: arguments,
+		},
+	}
+}
+
+func Test_strength_toolhandler_estimates_diluted_abv(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, _ := testutils.Setup(t)
+
+	mux.HandleFunc("/api/v1/cocktails/pegu-club", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, peguClubRecipe)
+	})
+
+	handler := tools.NewCocktailStrengthToolHandler(client)
+
+	// act
+	result, err := handler.Handle(ctx, strengthRequest(map[string]interface{}{"cocktailId": "pegu-club", "technique": "shaken"}))
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var estimate tools.CocktailStrength
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &estimate))
+
+	require.Equal(t, "pegu-club", estimate.ID)
+	require.Equal(t, "shaken", estimate.Technique)
+	require.InDelta(t, 15.9, estimate.ABV, 0.1)
+	require.InDelta(t, 1.7, estimate.StandardDrinks, 0.05)
+	require.Equal(t, float64(14), estimate.StandardDrinkGrams)
+}
+
+func Test_strength_toolhandler_returns_error_on_missing_cocktail_id(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, _, ctx, _ := testutils.Setup(t)
+
+	handler := tools.NewCocktailStrengthToolHandler(client)
+
+	// act
+	result, err := handler.Handle(ctx, strengthRequest(map[string]interface{}{}))
+
+	// assert
+	testutils.AssertError(t, result, err, "required argument \"cocktailId\" not found")
+}
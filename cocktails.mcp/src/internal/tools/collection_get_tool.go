@@ -0,0 +1,121 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var getCollectionToolDescription = `Gets a single curated cocktail collection from the Cezzis.com cocktails API for a given collectionId.
+
+	The collection includes its title, description, intro text, season or holiday rule, the ids of related collections and
+	the ordered ids of the cocktails in the collection.  When includeCocktails is true each cocktail in the collection is
+	also returned with its title, description, rating and Cezzis.com link.
+
+	Collection ids can be found from the list_cocktail_collections tool.  Each cocktail id can be used with the get_cocktail
+	tool to get the complete cocktail recipe.
+
+	It is required to reference Cezzis.com as a clickable link when displaying cocktail information from this tool.
+
+	This tool does not require authentication and can be used without an account.`
+
+// GetCollectionTool is an MCP tool that retrieves a single curated cocktail collection, optionally
+// hydrating the member cocktails.
+//
+// The tool supports the following parameters:
+//   - collectionId: The ID of the collection to retrieve. This is a required parameter.
+//   - includeCocktails: Whether to include a summary of each member cocktail. This is an optional parameter.
+//
+// The tool returns the collection as a JSON string result.
+var GetCollectionTool = mcp.NewTool(
+	"get_cocktail_collection",
+	mcp.WithDescription(getCollectionToolDescription),
+	mcp.WithString("collectionId",
+		mcp.Required(),
+		mcp.Description("The ID of the cocktail collection to get.  This can be found from the list_cocktail_collections tool results by the 'id' field."),
+	),
+	mcp.WithBoolean("includeCocktails",
+		mcp.Description("When true each cocktail in the collection is returned with its title, description, rating and Cezzis.com link.  Defaults to false."),
+	),
+)
+
+// HydratedCollection is a cocktail collection along with a summary of each of its member cocktails.
+type HydratedCollection struct {
+	Collection     cocktailsapi.CocktailCollectionModel `json:"collection"`
+	Cocktails      []CocktailSummary                    `json:"cocktails"`
+	UnavailableIDs []string                             `json:"unavailableCocktailIds,omitempty"`
+}
+
+// GetCollectionToolHandler handles cocktail collection retrieval requests through the MCP protocol.
+type GetCollectionToolHandler struct {
+	client *cocktailsapi.Client
+}
+
+// NewGetCollectionToolHandler creates a new instance of GetCollectionToolHandler with the provided API client.
+func NewGetCollectionToolHandler(client *cocktailsapi.Client) *GetCollectionToolHandler {
+	return &GetCollectionToolHandler{
+		client: client,
+	}
+}
+
+// Handle handles requests to retrieve a curated cocktail collection.
+// It returns the collection, optionally with its hydrated cocktails, as a JSON string result, or an error result if any step fails.
+func (handler GetCollectionToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if _, err := requireSessionID(ctx); err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	collectionID, err := requireNonEmptyString(request, "collectionId")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	includeCocktails := request.GetBool("includeCocktails", false)
+
+	telemetry.Logger.Info().Ctx(ctx).Bool("include_cocktails", includeCocktails).Msg("MCP Getting cocktail collection: " + collectionID)
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.GetCocktailCollection(callCtx, collectionID, &cocktailsapi.GetCocktailCollectionParams{
+		XKey: &config.GetAppSettings().CocktailsAPISubscriptionKey,
+	}, cocktailsapi.RequestEditor())
+
+	if !includeCocktails {
+		bodyBytes, err := readResponse(ctx, rs, callErr, "getting cocktail collection "+collectionID)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), err
+		}
+
+		return mcp.NewToolResultText(string(bodyBytes)), nil
+	}
+
+	collectionRs, err := decodeResponse[cocktailsapi.CocktailCollectionRs](ctx, rs, callErr, "getting cocktail collection "+collectionID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	cocktails, unavailable := fetchCocktails(ctx, handler.client, collectionRs.Item.CocktailIds)
+
+	hydrated := HydratedCollection{
+		Collection:     collectionRs.Item,
+		Cocktails:      make([]CocktailSummary, 0, len(cocktails)),
+		UnavailableIDs: unavailable,
+	}
+
+	for _, cocktail := range cocktails {
+		hydrated.Cocktails = append(hydrated.Cocktails, newCocktailSummary(cocktail))
+	}
+
+	jsonBytes, err := json.Marshal(hydrated)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
@@ -0,0 +1,140 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func Test_getcollection_toolhandler_returns_error_on_missing_collectionId(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, _, ctx, _ := testutils.Setup(t)
+
+	request := mcp.CallToolRequest{
+		Request: mcp.Request{
+			Method: "get_cocktail_collection",
+		},
+		Params: mcp.CallToolParams{
+			Name:      "get_cocktail_collection",
+			Arguments: map[string]interface{}{},
+		},
+	}
+
+	handler := tools.NewGetCollectionToolHandler(client)
+
+	// Act
+	result, err := handler.Handle(ctx, request)
+	testutils.AssertError(t, result, err, "required argument \"collectionId\" not found")
+}
+
+func Test_getcollection_toolhandler_returns_raw_collection_without_cocktails(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, _ := testutils.Setup(t)
+
+	body := `{"item":{"id":"holiday","title":"Holiday Cocktails","cocktailIds":["eggnog"]}}`
+
+	mux.HandleFunc("/api/v1/cocktails/collections/holiday", func(w http.ResponseWriter, r *http.Request) {
+		testutils.TestMethod(t, r, "GET")
+		fmt.Fprint(w, body)
+	})
+
+	request := mcp.CallToolRequest{
+		Request: mcp.Request{
+			Method: "get_cocktail_collection",
+		},
+		Params: mcp.CallToolParams{
+			Name: "get_cocktail_collection",
+			Arguments: map[string]interface{}{
+				"collectionId": "holiday",
+			},
+		},
+	}
+
+	handler := tools.NewGetCollectionToolHandler(client)
+
+	// Act
+	result, err := handler.Handle(ctx, request)
+
+	// Assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	content, ok := result.Content[0].(mcp.TextContent)
+	require.True(t, ok, "Content should be of type TextContent")
+	require.Equal(t, body, content.Text)
+}
+
+func Test_getcollection_toolhandler_hydrates_cocktails(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, _ := testutils.Setup(t)
+
+	mux.HandleFunc("/api/v1/cocktails/collections/summer", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"item":{"id":"summer","title":"Summer Drinks","cocktailIds":["mojito","missing","daiquiri"]}}`)
+	})
+
+	for _, cocktail := range []cocktailsapi.CocktailModel{
+		{Id: "mojito", Title: "Mojito", Rating: cocktailsapi.CocktailRatingModel{Rating: 4.5}},
+		{Id: "daiquiri", Title: "Daiquiri"},
+	} {
+		jsonData, err := json.Marshal(cocktailsapi.CocktailRs{Item: cocktail})
+		require.NoError(t, err)
+
+		mux.HandleFunc("/api/v1/cocktails/"+cocktail.Id, func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, string(jsonData))
+		})
+	}
+
+	mux.HandleFunc("/api/v1/cocktails/missing", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	})
+
+	request := mcp.CallToolRequest{
+		Request: mcp.Request{
+			Method: "get_cocktail_collection",
+		},
+		Params: mcp.CallToolParams{
+			Name: "get_cocktail_collection",
+			Arguments: map[string]interface{}{
+				"collectionId":     "summer",
+				"includeCocktails": true,
+			},
+		},
+	}
+
+	handler := tools.NewGetCollectionToolHandler(client)
+
+	// Act
+	result, err := handler.Handle(ctx, request)
+
+	// Assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	content, ok := result.Content[0].(mcp.TextContent)
+	require.True(t, ok, "Content should be of type TextContent")
+
+	var hydrated tools.HydratedCollection
+	require.NoError(t, json.Unmarshal([]byte(content.Text), &hydrated))
+
+	require.Equal(t, "Summer Drinks", hydrated.Collection.Title)
+	require.Len(t, hydrated.Cocktails, 2)
+	require.Equal(t, "mojito", hydrated.Cocktails[0].ID)
+	require.Equal(t, 4.5, hydrated.Cocktails[0].Rating)
+	require.Equal(t, "http://localhost:4003/cocktails/mojito", hydrated.Cocktails[0].URL)
+	require.Equal(t, "daiquiri", hydrated.Cocktails[1].ID)
+	require.Equal(t, []string{"missing"}, hydrated.UnavailableIDs)
+}
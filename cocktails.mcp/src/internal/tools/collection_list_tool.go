@@ -0,0 +1,66 @@
+package tools
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var listCollectionsToolDescription = `Lists the curated cocktail collections from the Cezzis.com cocktails API.
+
+	Collections are hand picked groups of cocktails such as seasonal collections (summer drinks, winter warmers), holiday
+	collections (Christmas, Halloween, New Year's Eve) and themed collections.  Each collection includes its id, title,
+	short title, description, intro text, image url, season or holiday rule and the ids of related collections.
+
+	Curated collections are the best answer for requests such as "holiday cocktails" or "summer drinks".  Use the
+	get_cocktail_collection tool with a collection id to open a collection and get its cocktails.
+
+	This tool does not require authentication and can be used without an account.`
+
+// ListCollectionsTool is an MCP tool that lists the curated cocktail collections.
+//
+// The tool has no parameters and returns the raw API response as a string result.
+var ListCollectionsTool = mcp.NewTool(
+	"list_cocktail_collections",
+	mcp.WithDescription(listCollectionsToolDescription),
+)
+
+// ListCollectionsToolHandler handles cocktail collection listing requests through the MCP protocol.
+type ListCollectionsToolHandler struct {
+	client *cocktailsapi.Client
+}
+
+// NewListCollectionsToolHandler creates a new instance of ListCollectionsToolHandler with the provided API client.
+func NewListCollectionsToolHandler(client *cocktailsapi.Client) *ListCollectionsToolHandler {
+	return &ListCollectionsToolHandler{
+		client: client,
+	}
+}
+
+// Handle handles requests to list the curated cocktail collections.
+// It returns the raw API response as a string result, or an error result if any step fails.
+func (handler ListCollectionsToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if _, err := requireSessionID(ctx); err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP Listing cocktail collections")
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.GetCocktailCollections(callCtx, &cocktailsapi.GetCocktailCollectionsParams{
+		XKey: &config.GetAppSettings().CocktailsAPISubscriptionKey,
+	}, cocktailsapi.RequestEditor())
+
+	bodyBytes, err := readResponse(ctx, rs, callErr, "listing cocktail collections")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(bodyBytes)), nil
+}
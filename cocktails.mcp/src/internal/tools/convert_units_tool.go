@@ -0,0 +1,166 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+	"cezzis.com/cezzis-mcp-server/internal/units"
+)
+
+var convertUnitsToolDescription = `Converts an ingredient amount between units of measure, such as ounces to milliliters or barspoons to teaspoons.
+
+	Supported units are ounces (oz), milliliters (ml), centiliters (cl), cups, tablespoon (tbsp), teaspoon (tsp),
+	barspoon, dashes, splash and pinch.  Items only convert to items, and 'to taste', 'at your discretion' and
+	'top off' cannot be converted.
+
+	Dashes, barspoons, splashes and pinches have no fixed size.  By default a dash is 1/32 oz, a barspoon 1/8 oz,
+	a splash 1/4 oz and a pinch 1/16 teaspoon; supply dashMl, barspoonMl, splashMl or pinchMl to use a different
+	convention.
+
+	This tool does not require authentication and can be used without an account.`
+
+// ConvertUnitsTool is an MCP tool that converts an ingredient amount between units of measure.
+//
+// The tool supports the following parameters:
+//   - amount: The amount to convert. This is a required parameter.
+//   - from: The unit of the amount. This is a required parameter.
+//   - to: The unit to convert the amount to. This is a required parameter.
+//   - dashMl, barspoonMl, splashMl, pinchMl: Optional sizes, in milliliters, of the bar measures without a fixed volume.
+//
+// The tool returns the converted amount as JSON.
+var ConvertUnitsTool = mcp.NewTool(
+	"convert_units",
+	mcp.WithDescription(convertUnitsToolDescription),
+	mcp.WithNumber("amount",
+		mcp.Required(),
+		mcp.Description("The amount to convert."),
+		mcp.Min(0),
+	),
+	mcp.WithString("from",
+		mcp.Required(),
+		mcp.Description("The unit of the amount, such as 'oz', 'ml', 'cl', 'barspoon' or 'dashes'."),
+	),
+	mcp.WithString("to",
+		mcp.Required(),
+		mcp.Description("The unit to convert the amount to, such as 'oz', 'ml', 'cl', 'barspoon' or 'dashes'."),
+	),
+	mcp.WithNumber("dashMl",
+		mcp.Description("The size of a dash in milliliters.  Defaults to 1/32 oz (about 0.92 ml)."),
+	),
+	mcp.WithNumber("barspoonMl",
+		mcp.Description("The size of a barspoon in milliliters.  Defaults to 1/8 oz (about 3.7 ml)."),
+	),
+	mcp.WithNumber("splashMl",
+		mcp.Description("The size of a splash in milliliters.  Defaults to 1/4 oz (about 7.4 ml)."),
+	),
+	mcp.WithNumber("pinchMl",
+		mcp.Description("The size of a pinch in milliliters.  Defaults to 1/16 teaspoon (about 0.31 ml)."),
+	),
+)
+
+// UnitConversion is the result of converting an amount between units of measure.
+type UnitConversion struct {
+	Amount      float64           `json:"amount"`
+	From        string            `json:"from"`
+	To          string            `json:"to"`
+	Result      float64           `json:"result"`
+	Display     string            `json:"display"`
+	Conventions units.Conventions `json:"conventions"`
+}
+
+// ConvertUnitsToolHandler handles unit conversion requests through the MCP protocol.
+type ConvertUnitsToolHandler struct{}
+
+// NewConvertUnitsToolHandler creates a new instance of ConvertUnitsToolHandler.
+func NewConvertUnitsToolHandler() *ConvertUnitsToolHandler {
+	return &ConvertUnitsToolHandler{}
+}
+
+// Handle handles requests to convert an amount between units of measure.
+// It returns the conversion as JSON, or an error result if the units are unknown or cannot be converted.
+func (handler ConvertUnitsToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if _, err := requireSessionID(ctx); err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	amount, err := request.RequireFloat("amount")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	if amount < 0 {
+		err := errors.New("argument \"amount\" must not be negative")
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	from, err := requireUnit(request, "from")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	to, err := requireUnit(request, "to")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	conventions := conventionsFromRequest(request)
+	if err := conventions.Validate(); err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg(fmt.Sprintf("MCP Converting units: %s to %s", from, to))
+
+	result, err := conventions.Convert(amount, from, to)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	conversion := UnitConversion{
+		Amount:      amount,
+		From:        string(from),
+		To:          string(to),
+		Result:      units.Round(result, 0.001),
+		Display:     strings.Join(compactStrings([]string{units.FormatAmount(result, to), units.Label(to, result)}), " "),
+		Conventions: conventions,
+	}
+
+	jsonBytes, err := json.Marshal(conversion)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(jsonBytes)), nil
+}
+
+// requireUnit returns the unit of measure named by the required string argument.
+func requireUnit(request mcp.CallToolRequest, name string) (units.Unit, error) {
+	value, err := requireNonEmptyString(request, name)
+	if err != nil {
+		return "", err
+	}
+
+	unit, err := units.ParseUnit(value)
+	if err != nil {
+		return "", fmt.Errorf("argument %q: %w", name, err)
+	}
+
+	return unit, nil
+}
+
+// conventionsFromRequest returns the default conventions overridden by any sizes supplied in the request.
+func conventionsFromRequest(request mcp.CallToolRequest) units.Conventions {
+	defaults := units.DefaultConventions()
+
+	return units.Conventions{
+		DashMl:     request.GetFloat("dashMl", defaults.DashMl),
+		BarspoonMl: request.GetFloat("barspoonMl", defaults.BarspoonMl),
+		SplashMl:   request.GetFloat("splashMl", defaults.SplashMl),
+		PinchMl:    request.GetFloat("pinchMl", defaults.PinchMl),
+	}
+}
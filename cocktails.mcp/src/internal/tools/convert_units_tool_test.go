@@ -0,0 +1,118 @@
+package tools_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/middleware"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func convertUnitsRequest(arguments map[string]interface{}) mcp.CallToolRequest {
+	return mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name:      "convert_units",
+			Arguments: arguments,
+		},
+	}
+}
+
+func Test_convert_units_toolhandler_converts_ounces_to_milliliters(t *testing.T) {
+	// arrange
+	t.Parallel()
+	ctx := context.WithValue(context.Background(), middleware.McpSessionIDKey, "test-session-id")
+	handler := tools.NewConvertUnitsToolHandler()
+
+	// act
+	result, err := handler.Handle(ctx, convertUnitsRequest(map[string]interface{}{"amount": 1.5, "from": "oz", "to": "ml"}))
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var conversion tools.UnitConversion
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &conversion))
+
+	require.Equal(t, "ounces", conversion.From)
+	require.Equal(t, "ml", conversion.To)
+	require.InDelta(t, 44.36, conversion.Result, 0.01)
+	require.Equal(t, "44.4 ml", conversion.Display)
+}
+
+func Test_convert_units_toolhandler_applies_custom_dash_size(t *testing.T) {
+	// arrange
+	t.Parallel()
+	ctx := context.WithValue(context.Background(), middleware.McpSessionIDKey, "test-session-id")
+	handler := tools.NewConvertUnitsToolHandler()
+
+	// act
+	result, err := handler.Handle(ctx, convertUnitsRequest(map[string]interface{}{"amount": 3, "from": "ml", "to": "dashes", "dashMl": 0.6}))
+
+	// assert
+	require.NoError(t, err)
+
+	var conversion tools.UnitConversion
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &conversion))
+
+	require.InDelta(t, 5, conversion.Result, 0.001)
+	require.Equal(t, "5 dashes", conversion.Display)
+	require.Equal(t, 0.6, conversion.Conventions.DashMl)
+}
+
+func Test_convert_units_toolhandler_never_displays_a_dash_as_zero_ounces(t *testing.T) {
+	// arrange
+	t.Parallel()
+	ctx := context.WithValue(context.Background(), middleware.McpSessionIDKey, "test-session-id")
+	handler := tools.NewConvertUnitsToolHandler()
+
+	// act
+	result, err := handler.Handle(ctx, convertUnitsRequest(map[string]interface{}{"amount": 1, "from": "dash", "to": "oz"}))
+
+	// assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	var conversion tools.UnitConversion
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].(mcp.TextContent).Text), &conversion))
+
+	require.InDelta(t, 0.031, conversion.Result, 0.001)
+	require.Equal(t, "0.031 oz", conversion.Display)
+}
+
+func Test_convert_units_toolhandler_rejects_invalid_conversions(t *testing.T) {
+	t.Parallel()
+	ctx := context.WithValue(context.Background(), middleware.McpSessionIDKey, "test-session-id")
+	handler := tools.NewConvertUnitsToolHandler()
+
+	tests := []struct {
+		name      string
+		arguments map[string]interface{}
+		expected  string
+	}{
+		{"unknown unit", map[string]interface{}{"amount": 1, "from": "gallon", "to": "ml"}, "unknown unit of measure \"gallon\""},
+		{"not convertible", map[string]interface{}{"amount": 1, "from": "item", "to": "oz"}, "units are not convertible"},
+		{"invalid convention", map[string]interface{}{"amount": 1, "from": "oz", "to": "dashes", "dashMl": -1}, "dashMl must be greater than zero"},
+		{"negative amount", map[string]interface{}{"amount": -1, "from": "oz", "to": "ml"}, "must not be negative"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := handler.Handle(ctx, convertUnitsRequest(test.arguments))
+			require.ErrorContains(t, err, test.expected)
+			require.True(t, result.IsError)
+		})
+	}
+}
+
+func Test_convert_units_toolhandler_requires_session(t *testing.T) {
+	t.Parallel()
+	handler := tools.NewConvertUnitsToolHandler()
+
+	result, err := handler.Handle(context.Background(), convertUnitsRequest(map[string]interface{}{"amount": 1, "from": "oz", "to": "ml"}))
+	require.Error(t, err)
+	require.True(t, result.IsError)
+}
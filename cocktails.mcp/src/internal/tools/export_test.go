@@ -0,0 +1,17 @@
+package tools
+
+import "context"
+
+// Unexported helpers exposed to the tools_test package.
+var (
+	FilterAndSortRatings     = filterAndSortRatings
+	MergeCocktailIDs         = mergeCocktailIDs
+	MergeSearchHistory       = mergeSearchHistory
+	ResolveMeasurementSystem = resolveMeasurementSystem
+	NewSearchHistoryRecorder = newSearchHistoryRecorder
+)
+
+// Record records the term in the search history synchronously rather than through the queue.
+func (recorder *searchHistoryRecorder) Record(ctx context.Context, term string) {
+	recorder.record(ctx, term)
+}
@@ -0,0 +1,65 @@
+package tools
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var getIngredientFiltersToolDescription = `Gets the categorized cocktail search filter taxonomy from the Cezzis.com cocktails API.
+
+	The filters are grouped into categories such as spirits, liqueurs aperitifs and amari, bitters, fruits and citrus,
+	juices and purees, sweeteners and syrups, mixers soda and water, dairy and eggs, wine beer and sake, and the eras
+	in which cocktails were established.  Each filter has an id, a display name and optionally a parent filter id.
+
+	Use this tool to discover valid filter ids before calling the search_cocktails tool with its filters parameter.
+
+	This tool does not require authentication and can be used without an account.`
+
+// GetIngredientFiltersTool is an MCP tool that retrieves the categorized cocktail search filter taxonomy.
+//
+// The tool has no parameters and returns the raw API response as a string result.
+var GetIngredientFiltersTool = mcp.NewTool(
+	"get_ingredient_filters",
+	mcp.WithDescription(getIngredientFiltersToolDescription),
+)
+
+// GetIngredientFiltersToolHandler handles ingredient filter taxonomy requests through the MCP protocol.
+type GetIngredientFiltersToolHandler struct {
+	client *cocktailsapi.Client
+}
+
+// NewGetIngredientFiltersToolHandler creates a new instance of GetIngredientFiltersToolHandler with the provided API client.
+func NewGetIngredientFiltersToolHandler(client *cocktailsapi.Client) *GetIngredientFiltersToolHandler {
+	return &GetIngredientFiltersToolHandler{
+		client: client,
+	}
+}
+
+// Handle handles requests to retrieve the cocktail ingredient filter taxonomy.
+// It returns the raw API response as a string result, or an error result if any step fails.
+func (handler GetIngredientFiltersToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if _, err := requireSessionID(ctx); err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP Getting cocktail ingredient filters")
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.GetCocktailIngredientFilters(callCtx, &cocktailsapi.GetCocktailIngredientFiltersParams{
+		XKey: &config.GetAppSettings().CocktailsAPISubscriptionKey,
+	}, cocktailsapi.RequestEditor())
+
+	bodyBytes, err := readResponse(ctx, rs, callErr, "getting cocktail ingredient filters")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(bodyBytes)), nil
+}
@@ -0,0 +1,79 @@
+package tools
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+var getIngredientToolDescription = `Gets a single ingredient from the Cezzis.com ingredient catalog for a given ingredientId.
+
+	The ingredient data includes its name, shelf display name, parent ingredient id, ordered taxonomy types (from broad
+	to specific), applications and the list of variations of the ingredient.  For example a whiskey ingredient may list
+	the bourbon and rye variations that can be substituted for it.
+
+	Ingredient ids can be found from the list_ingredients tool or from the ingredients of a cocktail returned by the
+	get_cocktail tool.
+
+	This tool does not require authentication and can be used without an account.`
+
+// GetIngredientTool is an MCP tool that retrieves a single ingredient, including its variations,
+// from the Cezzis.com ingredient catalog.
+//
+// The tool supports the following parameters:
+//   - ingredientId: The ID of the ingredient to retrieve. This is a required parameter.
+//
+// The tool returns the raw API response as a string result.
+var GetIngredientTool = mcp.NewTool(
+	"get_ingredient",
+	mcp.WithDescription(getIngredientToolDescription),
+	mcp.WithString("ingredientId",
+		mcp.Required(),
+		mcp.Description("The ID of the ingredient to get.  This can be found from the list_ingredients tool results or a cocktail's ingredients by the 'id' field."),
+	),
+)
+
+// GetIngredientToolHandler handles single ingredient retrieval requests through the MCP protocol.
+type GetIngredientToolHandler struct {
+	client *cocktailsapi.Client
+}
+
+// NewGetIngredientToolHandler creates a new instance of GetIngredientToolHandler with the provided API client.
+func NewGetIngredientToolHandler(client *cocktailsapi.Client) *GetIngredientToolHandler {
+	return &GetIngredientToolHandler{
+		client: client,
+	}
+}
+
+// Handle handles requests to retrieve a single ingredient with its variations.
+// It returns the raw API response as a string result, or an error result if any step fails.
+func (handler GetIngredientToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if _, err := requireSessionID(ctx); err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	ingredientID, err := requireNonEmptyString(request, "ingredientId")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Msg("MCP Getting ingredient: " + ingredientID)
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.GetIngredient(callCtx, ingredientID, &cocktailsapi.GetIngredientParams{
+		XKey: &config.GetAppSettings().CocktailsAPISubscriptionKey,
+	}, cocktailsapi.RequestEditor())
+
+	bodyBytes, err := readResponse(ctx, rs, callErr, "getting ingredient "+ingredientID)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(bodyBytes)), nil
+}
@@ -0,0 +1,106 @@
+package tools_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func Test_getingredient_toolhandler_returns_error_on_empty_ingredientId(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, _, ctx, _ := testutils.Setup(t)
+
+	request := mcp.CallToolRequest{
+		Request: mcp.Request{
+			Method: "get_ingredient",
+		},
+		Params: mcp.CallToolParams{
+			Name: "get_ingredient",
+			Arguments: map[string]interface{}{
+				"ingredientId": " ",
+			},
+		},
+	}
+
+	handler := tools.NewGetIngredientToolHandler(client)
+
+	// Act
+	result, err := handler.Handle(ctx, request)
+	testutils.AssertError(t, result, err, "required argument \"ingredientId\" is empty")
+}
+
+func Test_getingredient_toolhandler_returns_ingredient_with_variations(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, _ := testutils.Setup(t)
+
+	body := `{"item":{"id":"whiskey","name":"Whiskey","variations":[{"id":"bourbon-whiskey","name":"Bourbon"}]}}`
+
+	mux.HandleFunc("/api/v1/cocktails/ingredients/whiskey", func(w http.ResponseWriter, r *http.Request) {
+		testutils.TestMethod(t, r, "GET")
+		fmt.Fprint(w, body)
+	})
+
+	request := mcp.CallToolRequest{
+		Request: mcp.Request{
+			Method: "get_ingredient",
+		},
+		Params: mcp.CallToolParams{
+			Name: "get_ingredient",
+			Arguments: map[string]interface{}{
+				"ingredientId": "whiskey",
+			},
+		},
+	}
+
+	handler := tools.NewGetIngredientToolHandler(client)
+
+	// Act
+	result, err := handler.Handle(ctx, request)
+
+	// Assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	content, ok := result.Content[0].(mcp.TextContent)
+	require.True(t, ok, "Content should be of type TextContent")
+	require.Equal(t, body, content.Text)
+}
+
+func Test_getingredient_toolhandler_returns_error_on_not_found(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, _ := testutils.Setup(t)
+
+	mux.HandleFunc("/api/v1/cocktails/ingredients/unknown", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	})
+
+	request := mcp.CallToolRequest{
+		Request: mcp.Request{
+			Method: "get_ingredient",
+		},
+		Params: mcp.CallToolParams{
+			Name: "get_ingredient",
+			Arguments: map[string]interface{}{
+				"ingredientId": "unknown",
+			},
+		},
+	}
+
+	handler := tools.NewGetIngredientToolHandler(client)
+
+	// Act
+	result, err := handler.Handle(ctx, request)
+	testutils.AssertError(t, result, err, "getting ingredient unknown failed (status 404): not found")
+}
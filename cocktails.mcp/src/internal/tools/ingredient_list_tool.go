@@ -0,0 +1,114 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/cocktailsapi"
+	"cezzis.com/cezzis-mcp-server/internal/config"
+	"cezzis.com/cezzis-mcp-server/internal/telemetry"
+)
+
+const (
+	defaultIngredientsTake = 25
+	maxIngredientsTake     = 50
+)
+
+var listIngredientsToolDescription = `Lists the ingredient catalog from the Cezzis.com cocktails API.  The ingredient list is paged and each ingredient
+	includes its id, name, shelf display name, parent ingredient id, ordered taxonomy types (from broad to specific), applications
+	and any variations of the ingredient.
+
+	The ingredients can be narrowed to a branch of the ingredient taxonomy using the filters parameter, for example
+	["spirits", "whiskey"] returns only whiskeys.  Use the skip and take parameters to page through the catalog.
+
+	Use the get_ingredient tool to get a single ingredient with its variations, and the get_ingredient_filters tool to
+	discover the filter ids that can be used with the search_cocktails tool.
+
+	This tool does not require authentication and can be used without an account.`
+
+// ListIngredientsTool is an MCP tool that lists and pages through the Cezzis.com ingredient catalog.
+//
+// The tool supports the following parameters:
+//   - skip: The number of ingredients to skip for paging. This is an optional parameter.
+//   - take: The number of ingredients to return for paging. This is an optional parameter.
+//   - filters: A list of taxonomy nodes used to narrow the ingredients. This is an optional parameter.
+//
+// The tool returns the raw API response as a string result.
+var ListIngredientsTool = mcp.NewTool(
+	"list_ingredients",
+	mcp.WithDescription(listIngredientsToolDescription),
+	mcp.WithNumber("skip",
+		mcp.Description("The number of ingredients to skip from the start of the catalog, used for paging.  Defaults to 0."),
+		mcp.Min(0),
+	),
+	mcp.WithNumber("take",
+		mcp.Description(fmt.Sprintf("The number of ingredients to return, used for paging.  Defaults to %d, maximum of %d.", defaultIngredientsTake, maxIngredientsTake)),
+		mcp.Min(1),
+		mcp.Max(maxIngredientsTake),
+	),
+	mcp.WithArray("filters",
+		mcp.Description("An optional list of taxonomy nodes used to narrow the ingredients, such as [\"spirits\", \"whiskey\"]."),
+		mcp.WithStringItems(),
+	),
+)
+
+// ListIngredientsToolHandler handles ingredient catalog listing requests through the MCP protocol.
+type ListIngredientsToolHandler struct {
+	client *cocktailsapi.Client
+}
+
+// NewListIngredientsToolHandler creates a new instance of ListIngredientsToolHandler with the provided API client.
+func NewListIngredientsToolHandler(client *cocktailsapi.Client) *ListIngredientsToolHandler {
+	return &ListIngredientsToolHandler{
+		client: client,
+	}
+}
+
+// Handle handles requests to list a page of the ingredient catalog.
+// It returns the raw API response as a string result, or an error result if any step fails.
+func (handler ListIngredientsToolHandler) Handle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if _, err := requireSessionID(ctx); err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	skip := request.GetInt("skip", 0)
+	if skip < 0 {
+		err := errors.New("argument \"skip\" must be zero or greater")
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	take := request.GetInt("take", defaultIngredientsTake)
+	if take < 1 || take > maxIngredientsTake {
+		err := fmt.Errorf("argument \"take\" must be between 1 and %d", maxIngredientsTake)
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	skip32 := int32(skip)
+	take32 := int32(take)
+	params := &cocktailsapi.GetIngredientsParams{
+		Skip: &skip32,
+		Take: &take32,
+		XKey: &config.GetAppSettings().CocktailsAPISubscriptionKey,
+	}
+
+	if filters := compactStrings(request.GetStringSlice("filters", nil)); len(filters) > 0 {
+		params.Fi = &filters
+	}
+
+	telemetry.Logger.Info().Ctx(ctx).Int("skip", skip).Int("take", take).Msg("MCP Listing ingredients")
+
+	callCtx, cancel := withCallDeadline(ctx)
+	defer cancel()
+
+	rs, callErr := handler.client.GetIngredients(callCtx, params, cocktailsapi.RequestEditor())
+
+	bodyBytes, err := readResponse(ctx, rs, callErr, "listing ingredients")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), err
+	}
+
+	return mcp.NewToolResultText(string(bodyBytes)), nil
+}
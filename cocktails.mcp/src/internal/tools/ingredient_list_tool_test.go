@@ -0,0 +1,84 @@
+package tools_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/testutils"
+	"cezzis.com/cezzis-mcp-server/internal/tools"
+)
+
+func Test_listingredients_toolhandler_returns_error_on_invalid_take(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, _, ctx, _ := testutils.Setup(t)
+
+	request := mcp.CallToolRequest{
+		Request: mcp.Request{
+			Method: "list_ingredients",
+		},
+		Params: mcp.CallToolParams{
+			Name: "list_ingredients",
+			Arguments: map[string]interface{}{
+				"take": 51,
+			},
+		},
+	}
+
+	handler := tools.NewListIngredientsToolHandler(client)
+
+	// Act
+	result, err := handler.Handle(ctx, request)
+	testutils.AssertError(t, result, err, "argument \"take\" must be between 1 and 50")
+}
+
+func Test_listingredients_toolhandler_sends_paging_and_filters(t *testing.T) {
+	// arrange
+	t.Parallel()
+	testutils.LoadEnvironment("..", "..")
+	client, _, mux, ctx, _ := testutils.Setup(t)
+
+	body := `{"items":[{"id":"bourbon-whiskey","name":"Bourbon Whiskey","parentId":"whiskey"}],"skip":25,"take":10}`
+
+	mux.HandleFunc("/api/v1/cocktails/ingredients", func(w http.ResponseWriter, r *http.Request) {
+		testutils.TestMethod(t, r, "GET")
+		query := r.URL.Query()
+		require.Equal(t, "25", query.Get("skip"))
+		require.Equal(t, "10", query.Get("take"))
+		require.Equal(t, []string{"spirits", "whiskey"}, query["fi"])
+		fmt.Fprint(w, body)
+	})
+
+	request := mcp.CallToolRequest{
+		Request: mcp.Request{
+			Method: "list_ingredients",
+		},
+		Params: mcp.CallToolParams{
+			Name: "list_ingredients",
+			Arguments: map[string]interface{}{
+				"skip":    25,
+				"take":    10,
+				"filters": []interface{}{"spirits", "whiskey"},
+			},
+		},
+	}
+
+	handler := tools.NewListIngredientsToolHandler(client)
+
+	// Act
+	result, err := handler.Handle(ctx, request)
+
+	// Assert
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Len(t, result.Content, 1)
+
+	content, ok := result.Content[0].(mcp.TextContent)
+	require.True(t, ok, "Content should be of type TextContent")
+	require.Equal(t, body, content.Text)
+}
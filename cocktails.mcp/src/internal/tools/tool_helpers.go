@@ -0,0 +1,95 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+
+	"cezzis.com/cezzis-mcp-server/internal/api/upstream"
+	"cezzis.com/cezzis-mcp-server/internal/middleware"
+)
+
+// requireSessionID returns the MCP session identifier placed on the context by the
+// request middleware, or an error if the Mcp-Session-Id header was not supplied.
+func requireSessionID(ctx context.Context) (string, error) {
+	sessionID, ok := ctx.Value(middleware.McpSessionIDKey).(string)
+	if !ok || sessionID == "" {
+		return "", errors.New("missing required Mcp-Session-Id header")
+	}
+
+	return sessionID, nil
+}
+
+// withCallDeadline defaults the context to a safe deadline for upstream API calls if none is present.
+// The returned cancel function must always be called.
+func withCallDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(ctx, 30*time.Second)
+}
+
+// readResponse reads and closes the body of an upstream API response using upstream.ReadResponse.
+func readResponse(ctx context.Context, rs *http.Response, callErr error, operation string) ([]byte, error) {
+	return upstream.ReadResponse(ctx, rs, callErr, operation)
+}
+
+// decodeResponse reads an upstream API response and unmarshals the JSON body into T using upstream.DecodeResponse.
+func decodeResponse[T any](ctx context.Context, rs *http.Response, callErr error, operation string) (*T, error) {
+	return upstream.DecodeResponse[T](ctx, rs, callErr, operation)
+}
+
+// suppliedArray reports whether the argument was supplied as an array, which may be empty.  A missing or null
+// argument is not supplied.
+func suppliedArray(request mcp.CallToolRequest, key string) bool {
+	switch request.GetArguments()[key].(type) {
+	case []interface{}, []string:
+		return true
+	default:
+		return false
+	}
+}
+
+// requireNonEmptyString extracts a required string argument and ensures it is not blank.
+func requireNonEmptyString(request mcp.CallToolRequest, key string) (string, error) {
+	value, err := request.RequireString(key)
+	if err != nil {
+		return "", err
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", fmt.Errorf("required argument %q is empty", key)
+	}
+
+	return value, nil
+}
+
+// dedupeStrings removes repeated values while preserving the order in which they were first seen.
+func dedupeStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	deduped := make([]string, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			deduped = append(deduped, v)
+		}
+	}
+
+	return deduped
+}
+
+// stringValue returns the value of an optional string, or an empty string when it is nil.
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
@@ -0,0 +1,284 @@
+// Package units converts cocktail ingredient amounts between the units of measure used by
+// the Cezzis.com APIs and common bar measures such as milliliters, centiliters and ounces.
+// Bar measures without a fixed size, such as dashes and barspoons, are converted using
+// configurable Conventions.
+package units
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Unit is a unit of measure.  The values match the UofM values of the Cezzis.com APIs, plus the metric units.
+type Unit string
+
+// Defines the supported units of measure.
+const (
+	Ounces      Unit = "ounces"
+	Milliliters Unit = "ml"
+	Centiliters Unit = "cl"
+	Cups        Unit = "cups"
+	Tablespoon  Unit = "tablespoon"
+	Teaspoon    Unit = "teaspoon"
+	Barspoon    Unit = "barspoon"
+	Dashes      Unit = "dashes"
+	Splash      Unit = "splash"
+	Pinch       Unit = "pinch"
+	Item        Unit = "item"
+	ToTaste     Unit = "toTaste"
+	Discretion  Unit = "discretion"
+	Topoff      Unit = "topoff"
+	None        Unit = "none"
+)
+
+// Fixed volumes in milliliters.
+const (
+	MillilitersPerOunce      = 29.5735
+	millilitersPerCentiliter = 10
+	millilitersPerCup        = 236.588
+	millilitersPerTablespoon = 14.7868
+	millilitersPerTeaspoon   = 4.92892
+)
+
+// ErrNotConvertible is returned when an amount cannot be converted between two units, such as from a count of items
+// to milliliters or from 'to taste'.
+var ErrNotConvertible = errors.New("units are not convertible")
+
+// Conventions are the sizes, in milliliters, of the bar measures that have no fixed volume.
+type Conventions struct {
+	DashMl     float64 `json:"dashMl"`
+	BarspoonMl float64 `json:"barspoonMl"`
+	SplashMl   float64 `json:"splashMl"`
+	PinchMl    float64 `json:"pinchMl"`
+}
+
+// DefaultConventions returns the conventions used when none are supplied: a dash of 1/32 oz, a barspoon of 1/8 oz,
+// a splash of 1/4 oz and a pinch of 1/16 teaspoon.
+func DefaultConventions() Conventions {
+	return Conventions{
+		DashMl:     MillilitersPerOunce / 32,
+		BarspoonMl: MillilitersPerOunce / 8,
+		SplashMl:   MillilitersPerOunce / 4,
+		PinchMl:    millilitersPerTeaspoon / 16,
+	}
+}
+
+// unitInfo describes how a unit is displayed and rounded.
+type unitInfo struct {
+	label  string
+	plural string
+	// step is the bartender friendly increment amounts are rounded to, or zero for units that are not scalable
+	step float64
+}
+
+var unitInfos = map[Unit]unitInfo{
+	Ounces:      {label: "oz", plural: "oz", step: 0.25},
+	Milliliters: {label: "ml", plural: "ml", step: 5},
+	Centiliters: {label: "cl", plural: "cl", step: 0.5},
+	Cups:        {label: "cup", plural: "cups", step: 0.25},
+	Tablespoon:  {label: "tbsp", plural: "tbsp", step: 0.25},
+	Teaspoon:    {label: "tsp", plural: "tsp", step: 0.25},
+	Barspoon:    {label: "barspoon", plural: "barspoons", step: 0.25},
+	Dashes:      {label: "dash", plural: "dashes", step: 1},
+	Splash:      {label: "splash", plural: "splashes", step: 1},
+	Pinch:       {label: "pinch", plural: "pinches", step: 1},
+	Item:        {step: 0.5},
+	ToTaste:     {label: "to taste", plural: "to taste"},
+	Discretion:  {label: "at your discretion", plural: "at your discretion"},
+	Topoff:      {label: "top off", plural: "top off"},
+	None:        {},
+}
+
+// aliases lists the common spellings and abbreviations of each unit.
+var aliases = map[Unit][]string{
+	Ounces:      {"oz", "ounce", "fl oz", "floz"},
+	Milliliters: {"milliliter", "milliliters", "millilitre", "millilitres", "mls"},
+	Centiliters: {"centiliter", "centiliters", "centilitre", "centilitres"},
+	Cups:        {"cup"},
+	Tablespoon:  {"tbsp", "tablespoons"},
+	Teaspoon:    {"tsp", "teaspoons"},
+	Barspoon:    {"barspoons", "bar spoon", "bar spoons"},
+	Dashes:      {"dash"},
+	Splash:      {"splashes"},
+	Pinch:       {"pinches"},
+	Item:        {"items"},
+	ToTaste:     {"to taste"},
+	Topoff:      {"top off", "top"},
+}
+
+// ParseUnit parses a unit of measure from its API value, name or common abbreviation, ignoring case.
+func ParseUnit(value string) (Unit, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+
+	for unit := range unitInfos {
+		if strings.EqualFold(string(unit), normalized) || slices.Contains(aliases[unit], normalized) {
+			return unit, nil
+		}
+	}
+
+	return "", fmt.Errorf("unknown unit of measure %q", value)
+}
+
+// Scalable reports whether amounts in the unit can be scaled.  Units such as 'to taste' and 'top off' cannot.
+func Scalable(unit Unit) bool {
+	return unitInfos[unit].step > 0
+}
+
+// Label returns the singular or plural display label of the unit for the amount.  Items have no label.
+func Label(unit Unit, amount float64) string {
+	if amount > 1 {
+		return unitInfos[unit].plural
+	}
+	return unitInfos[unit].label
+}
+
+// Milliliters returns the volume of the amount in milliliters.  It returns ErrNotConvertible for units that do not
+// measure volume.
+func (c Conventions) Milliliters(amount float64, unit Unit) (float64, error) {
+	var perUnit float64
+
+	switch unit {
+	case Milliliters:
+		perUnit = 1
+	case Centiliters:
+		perUnit = millilitersPerCentiliter
+	case Ounces:
+		perUnit = MillilitersPerOunce
+	case Cups:
+		perUnit = millilitersPerCup
+	case Tablespoon:
+		perUnit = millilitersPerTablespoon
+	case Teaspoon:
+		perUnit = millilitersPerTeaspoon
+	case Barspoon:
+		perUnit = c.BarspoonMl
+	case Dashes:
+		perUnit = c.DashMl
+	case Splash:
+		perUnit = c.SplashMl
+	case Pinch:
+		perUnit = c.PinchMl
+	default:
+		return 0, fmt.Errorf("%w: %s does not measure volume", ErrNotConvertible, unit)
+	}
+
+	return amount * perUnit, nil
+}
+
+// Convert converts the amount between units.  Amounts convert between any units that measure volume, and items
+// only convert to items.  It returns ErrNotConvertible for any other combination.
+func (c Conventions) Convert(amount float64, from, to Unit) (float64, error) {
+	if from == to && Scalable(from) {
+		return amount, nil
+	}
+
+	ml, err := c.Milliliters(amount, from)
+	if err != nil {
+		return 0, err
+	}
+
+	perUnit, err := c.Milliliters(1, to)
+	if err != nil {
+		return 0, err
+	}
+
+	if perUnit <= 0 {
+		return 0, fmt.Errorf("%w: the size of a %s must be greater than zero", ErrNotConvertible, to)
+	}
+
+	return ml / perUnit, nil
+}
+
+// Validate returns an error if any of the conventions is not a positive volume.
+func (c Conventions) Validate() error {
+	sizes := []struct {
+		name  string
+		value float64
+	}{
+		{"dashMl", c.DashMl},
+		{"barspoonMl", c.BarspoonMl},
+		{"splashMl", c.SplashMl},
+		{"pinchMl", c.PinchMl},
+	}
+
+	for _, size := range sizes {
+		if size.value <= 0 {
+			return fmt.Errorf("%s must be greater than zero", size.name)
+		}
+	}
+
+	return nil
+}
+
+// Friendly rounds the amount to the unit's bartender friendly increment, such as quarter ounces or whole dashes.
+// Small non-zero amounts are never rounded away: they become an eighth for fractional increments or a single unit
+// for whole increments.  Amounts in units that are not scalable are returned unchanged.
+func Friendly(amount float64, unit Unit) float64 {
+	step := unitInfos[unit].step
+	if step == 0 {
+		return amount
+	}
+
+	rounded := Round(amount, step)
+	if rounded > 0 || amount <= 0 {
+		return rounded
+	}
+
+	if step < 1 {
+		return 0.125
+	}
+	return step
+}
+
+// Round rounds the value to the nearest multiple of step.
+func Round(value, step float64) float64 {
+	return math.Round(value/step) * step
+}
+
+// FormatAmount formats the amount for the unit.  Metric amounts are shown as decimals and everything else as a whole
+// number with a common bartending fraction, such as "1 3/4" or "1/8".  Non-zero amounts too small to show that way
+// are shown with two significant digits rather than as "0".
+func FormatAmount(amount float64, unit Unit) string {
+	if unit == Milliliters || unit == Centiliters {
+		if amount > 0 && amount < 0.05 {
+			return formatSmall(amount)
+		}
+		return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.1f", amount), "0"), ".")
+	}
+
+	return FormatFraction(amount)
+}
+
+// FormatFraction formats the amount as a whole number with the nearest eighth, such as "1 3/4" or "1/8".  Non-zero
+// amounts below a sixteenth, which would round to "0", are shown with two significant digits, such as "0.031".
+func FormatFraction(amount float64) string {
+	whole := math.Floor(amount)
+	eighths := int(math.Round((amount - whole) * 8))
+	if eighths == 8 {
+		whole, eighths = whole+1, 0
+	}
+
+	if whole == 0 && eighths == 0 && amount > 0 {
+		return formatSmall(amount)
+	}
+
+	fractions := map[int]string{1: "1/8", 2: "1/4", 3: "3/8", 4: "1/2", 5: "5/8", 6: "3/4", 7: "7/8"}
+
+	switch {
+	case eighths == 0:
+		return fmt.Sprintf("%d", int(whole))
+	case whole == 0:
+		return fractions[eighths]
+	default:
+		return fmt.Sprintf("%d %s", int(whole), fractions[eighths])
+	}
+}
+
+// formatSmall formats a small amount as a decimal with two significant digits.
+func formatSmall(amount float64) string {
+	return strconv.FormatFloat(amount, 'g', 2, 64)
+}
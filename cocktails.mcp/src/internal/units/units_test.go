@@ -0,0 +1,112 @@
+package units_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cezzis.com/cezzis-mcp-server/internal/units"
+)
+
+func Test_parse_unit_accepts_api_values_and_abbreviations(t *testing.T) {
+	t.Parallel()
+
+	for value, expected := range map[string]units.Unit{
+		"ounces":    units.Ounces,
+		"OZ":        units.Ounces,
+		" ml ":      units.Milliliters,
+		"cl":        units.Centiliters,
+		"tbsp":      units.Tablespoon,
+		"Bar Spoon": units.Barspoon,
+		"dash":      units.Dashes,
+		"toTaste":   units.ToTaste,
+		"topoff":    units.Topoff,
+	} {
+		unit, err := units.ParseUnit(value)
+		require.NoError(t, err, value)
+		require.Equal(t, expected, unit, value)
+	}
+
+	_, err := units.ParseUnit("gallon")
+	require.ErrorContains(t, err, "unknown unit of measure \"gallon\"")
+}
+
+func Test_convert_between_volume_units(t *testing.T) {
+	t.Parallel()
+
+	conventions := units.DefaultConventions()
+
+	ml, err := conventions.Convert(2, units.Ounces, units.Milliliters)
+	require.NoError(t, err)
+	require.InDelta(t, 59.147, ml, 0.001)
+
+	oz, err := conventions.Convert(4.5, units.Centiliters, units.Ounces)
+	require.NoError(t, err)
+	require.InDelta(t, 1.5217, oz, 0.001)
+
+	teaspoons, err := conventions.Convert(1, units.Tablespoon, units.Teaspoon)
+	require.NoError(t, err)
+	require.InDelta(t, 3, teaspoons, 0.001)
+
+	items, err := conventions.Convert(2, units.Item, units.Item)
+	require.NoError(t, err)
+	require.Equal(t, float64(2), items)
+}
+
+func Test_convert_uses_configurable_conventions(t *testing.T) {
+	t.Parallel()
+
+	conventions := units.DefaultConventions()
+
+	dashes, err := conventions.Convert(1, units.Ounces, units.Dashes)
+	require.NoError(t, err)
+	require.InDelta(t, 32, dashes, 0.001)
+
+	conventions.DashMl = 0.5
+	ml, err := conventions.Convert(4, units.Dashes, units.Milliliters)
+	require.NoError(t, err)
+	require.InDelta(t, 2, ml, 0.001)
+}
+
+func Test_convert_rejects_units_that_do_not_measure_volume(t *testing.T) {
+	t.Parallel()
+
+	conventions := units.DefaultConventions()
+
+	_, err := conventions.Convert(1, units.Item, units.Ounces)
+	require.ErrorIs(t, err, units.ErrNotConvertible)
+
+	_, err = conventions.Convert(1, units.Ounces, units.ToTaste)
+	require.ErrorIs(t, err, units.ErrNotConvertible)
+
+	conventions.BarspoonMl = 0
+	_, err = conventions.Convert(1, units.Ounces, units.Barspoon)
+	require.ErrorIs(t, err, units.ErrNotConvertible)
+	require.ErrorContains(t, conventions.Validate(), "barspoonMl must be greater than zero")
+}
+
+func Test_friendly_rounds_to_bartender_increments(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 1.75, units.Friendly(1.8, units.Ounces))
+	require.Equal(t, 0.125, units.Friendly(0.05, units.Ounces))
+	require.Equal(t, float64(1), units.Friendly(0.2, units.Dashes))
+	require.Equal(t, float64(45), units.Friendly(44.4, units.Milliliters))
+	require.Equal(t, 0.3, units.Friendly(0.3, units.ToTaste))
+	require.False(t, units.Scalable(units.Topoff))
+}
+
+func Test_format_amount(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "1 3/4", units.FormatAmount(1.75, units.Ounces))
+	require.Equal(t, "1/8", units.FormatAmount(0.125, units.Barspoon))
+	require.Equal(t, "2", units.FormatAmount(1.97, units.Ounces))
+	require.Equal(t, "59.1", units.FormatAmount(59.147, units.Milliliters))
+	require.Equal(t, "4.5", units.FormatAmount(4.5, units.Centiliters))
+	require.Equal(t, "0.031", units.FormatAmount(0.03125, units.Ounces))
+	require.Equal(t, "0.02", units.FormatAmount(0.02, units.Milliliters))
+	require.Equal(t, "0", units.FormatAmount(0, units.Ounces))
+	require.Equal(t, "dashes", units.Label(units.Dashes, 2))
+	require.Equal(t, "dash", units.Label(units.Dashes, 1))
+}